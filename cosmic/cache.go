@@ -0,0 +1,68 @@
+package cosmic
+
+import (
+	"sync"
+	"time"
+)
+
+// lookupKey identifies a name to ID lookup
+type lookupKey struct {
+	kind    string
+	name    string
+	zone    string
+	project string
+}
+
+type lookupEntry struct {
+	id      string
+	expires time.Time
+}
+
+// lookupCache caches the IDs retrieved by name, so resources using the same
+// offerings, zones, projects and templates don't all have to look them up. A
+// nil cache is valid and caches nothing.
+type lookupCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[lookupKey]lookupEntry
+}
+
+// newLookupCache returns a cache whose entries expire after the given TTL. If
+// the TTL is 0, entries never expire.
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		ttl:     ttl,
+		entries: make(map[lookupKey]lookupEntry),
+	}
+}
+
+func (c *lookupCache) get(key lookupKey) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || (!e.expires.IsZero() && time.Now().After(e.expires)) {
+		return "", false
+	}
+
+	return e.id, true
+}
+
+func (c *lookupCache) set(key lookupKey, id string) {
+	if c == nil {
+		return
+	}
+
+	e := lookupEntry{id: id}
+	if c.ttl > 0 {
+		e.expires = time.Now().Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = e
+	c.mu.Unlock()
+}
@@ -0,0 +1,125 @@
+package cosmic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLookupCache(t *testing.T) {
+	key := lookupKey{kind: "zone", name: "zone-1"}
+
+	c := newLookupCache(0)
+	if _, ok := c.get(key); ok {
+		t.Fatal("expected an empty cache")
+	}
+
+	c.set(key, "1c2c8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a")
+	if id, ok := c.get(key); !ok || id != "1c2c8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a" {
+		t.Fatalf("bad cached ID: %q", id)
+	}
+
+	c = newLookupCache(time.Millisecond)
+	c.set(key, "1c2c8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a")
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := c.get(key); ok {
+		t.Fatal("expected the cached ID to be expired")
+	}
+
+	// A nil cache is disabled
+	var disabled *lookupCache
+	disabled.set(key, "1c2c8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a")
+	if _, ok := disabled.get(key); ok {
+		t.Fatal("expected a disabled cache to cache nothing")
+	}
+}
+
+// testLookupServer returns a server answering listServiceOfferings and
+// listTemplates calls, and counting the calls made.
+func testLookupServer(calls map[string]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		calls[q.Get("command")]++
+
+		switch q.Get("command") {
+		case "listServiceOfferings":
+			fmt.Fprintf(w, `{"listserviceofferingsresponse":{"count":1,"serviceoffering":[`+
+				`{"id":"4c2b8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a","name":"%s"}]}}`, q.Get("name"))
+		case "listTemplates":
+			// The template ID ends with the ID of the project it is listed in
+			fmt.Fprintf(w, `{"listtemplatesresponse":{"count":1,"template":[`+
+				`{"id":"5d3c9b4f-1b5f-4e79-c4e5-%s","name":"%s"}]}}`,
+				q.Get("projectid")[24:], q.Get("name"))
+		}
+	}))
+}
+
+func TestRetrieveIDCached(t *testing.T) {
+	for _, cached := range []bool{true, false} {
+		calls := make(map[string]int)
+		server := testLookupServer(calls)
+		defer server.Close()
+
+		c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10, LookupCache: cached}
+		cs, err := c.NewClient()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			id, e := retrieveID(cs, "service_offering", "small")
+			if e != nil {
+				t.Fatalf("err: %s", e.Error())
+			}
+			if id != "4c2b8a3e-0a4e-4d68-b3d4-1d0c2b1f6e2a" {
+				t.Fatalf("bad ID: %s", id)
+			}
+		}
+
+		expected := 2
+		if cached {
+			expected = 1
+		}
+		if calls["listServiceOfferings"] != expected {
+			t.Fatalf("bad number of lookups with cache %t: %d (expected %d)",
+				cached, calls["listServiceOfferings"], expected)
+		}
+	}
+}
+
+func TestRetrieveTemplateIDCached(t *testing.T) {
+	calls := make(map[string]int)
+	server := testLookupServer(calls)
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10, LookupCache: true}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	zoneid := "3a1b7f2d-9f3d-4c57-a2c3-0e9b1a0f5d19"
+	projects := []string{
+		"6e4dac50-2c60-4f8a-d5f6-000000000001",
+		"6e4dac50-2c60-4f8a-d5f6-000000000002",
+		"6e4dac50-2c60-4f8a-d5f6-000000000001",
+	}
+
+	for _, project := range projects {
+		id, e := retrieveTemplateID(cs, zoneid, project, "centos")
+		if e != nil {
+			t.Fatalf("err: %s", e.Error())
+		}
+
+		// Lookups in another project are cached separately
+		if id[24:] != project[24:] {
+			t.Fatalf("bad ID for project %s: %s", project, id)
+		}
+	}
+
+	if calls["listTemplates"] != 2 {
+		t.Fatalf("bad number of lookups: %d (expected 2)", calls["listTemplates"])
+	}
+}
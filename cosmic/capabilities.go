@@ -0,0 +1,184 @@
+package cosmic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// capabilities holds the capabilities of the Cosmic API, which are retrieved
+// once when the provider is configured.
+type capabilities struct {
+	// Cosmic is true if the API is a Cosmic API and not a CloudStack API
+	Cosmic bool
+
+	// Version is the version reported by the API
+	Version string
+
+	// APIs maps the name of every available API command to its parameters.
+	// It is nil if the available commands could not be retrieved.
+	APIs map[string]map[string]bool
+}
+
+// resourceAPIs maps resources to the API command used to create them
+var resourceAPIs = map[string]string{
+	"cosmic_affinity_group":       "createAffinityGroup",
+	"cosmic_disk":                 "createVolume",
+	"cosmic_instance":             "deployVirtualMachine",
+	"cosmic_instance_group":       "createInstanceGroup",
+	"cosmic_ipaddress":            "associateIpAddress",
+	"cosmic_iso":                  "registerIso",
+	"cosmic_loadbalancer_rule":    "createLoadBalancerRule",
+	"cosmic_network":              "createNetwork",
+	"cosmic_network_acl":          "createNetworkACLList",
+	"cosmic_network_acl_rule":     "createNetworkACL",
+	"cosmic_nic":                  "addNicToVirtualMachine",
+	"cosmic_port_forward":         "createPortForwardingRule",
+	"cosmic_private_gateway":      "createPrivateGateway",
+	"cosmic_secondary_ipaddress":  "addIpToNic",
+	"cosmic_ssh_keypair":          "registerSSHKeyPair",
+	"cosmic_static_nat":           "enableStaticNat",
+	"cosmic_static_route":         "createStaticRoute",
+	"cosmic_template":             "registerTemplate",
+	"cosmic_vm_snapshot":          "createVMSnapshot",
+	"cosmic_volume_snapshot":      "createSnapshot",
+	"cosmic_vpc":                  "createVPC",
+	"cosmic_vpn_connection":       "createVpnConnection",
+	"cosmic_vpn_customer_gateway": "createVpnCustomerGateway",
+	"cosmic_vpn_gateway":          "createVpnGateway",
+}
+
+// argumentAPIs maps arguments that are not supported by every version of the
+// API to the API command and parameter they require
+var argumentAPIs = map[string]map[string][2]string{
+	"cosmic_instance": {
+		"iso":          {"attachIso", ""},
+		"optimise_for": {"deployVirtualMachine", "optimisefor"},
+	},
+	"cosmic_network": {
+		"ip_exclusion_list": {"createNetwork", "ipexclusionlist"},
+	},
+	"cosmic_vm_snapshot": {
+		"snapshot_memory": {"createVMSnapshot", "snapshotmemory"},
+	},
+	"cosmic_volume_snapshot": {
+		"vm_snapshot_id": {"createSnapshotFromVMSnapshot", ""},
+	},
+	"cosmic_vpc": {
+		"source_nat_list":    {"createVPC", "sourcenatlist"},
+		"syslog_server_list": {"createVPC", "syslogserverlist"},
+	},
+}
+
+// loadCapabilities retrieves the capabilities and the available API commands.
+// Errors are logged instead of returned, so the provider still works with
+// accounts that are not allowed to list the API commands.
+func (c *Config) loadCapabilities(cs *Client) *capabilities {
+	caps := &capabilities{}
+
+	r, err := cs.Configuration.ListCapabilities(cs.Configuration.NewListCapabilitiesParams())
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve the capabilities of the Cosmic API: %s", err)
+	} else {
+		caps.Cosmic = r.Capabilities.Cosmic
+		caps.Version = r.Capabilities.Cloudstackversion
+	}
+
+	apis, err := c.listAPIs(cs)
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve the available API commands: %s", err)
+	} else {
+		caps.APIs = apis
+	}
+
+	log.Printf("[DEBUG] Cosmic API version %s supports %d API commands", caps.Version, len(caps.APIs))
+
+	return caps
+}
+
+// listAPIs returns the available API commands and their parameters. go-cosmic
+// doesn't implement the listApis command, so the request is made directly.
+func (c *Config) listAPIs(cs *Client) (map[string]map[string]bool, error) {
+	b, err := cs.rawRequest("listApis", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Count int `json:"count"`
+		API   []struct {
+			Name   string `json:"name"`
+			Params []struct {
+				Name string `json:"name"`
+			} `json:"params"`
+		} `json:"api"`
+	}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+
+	apis := make(map[string]map[string]bool, r.Count)
+	for _, api := range r.API {
+		ps := make(map[string]bool, len(api.Params))
+		for _, p := range api.Params {
+			ps[p.Name] = true
+		}
+		apis[api.Name] = ps
+	}
+
+	return apis, nil
+}
+
+// supports returns true if the API supports the given command and parameter.
+// If the available commands are unknown, everything is assumed to be supported.
+func (c *capabilities) supports(api, param string) bool {
+	if c == nil || c.APIs == nil {
+		return true
+	}
+
+	params, ok := c.APIs[api]
+	if !ok {
+		return false
+	}
+
+	return param == "" || params[param]
+}
+
+// customizeCapabilitiesDiff wraps the CustomizeDiff function of a resource,
+// to fail at plan time if the resource or one of its configured arguments is
+// not supported by the API.
+func customizeCapabilitiesDiff(name string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		cs, ok := meta.(*Client)
+		if !ok {
+			return nil
+		}
+
+		if api, ok := resourceAPIs[name]; ok && !cs.capabilities.supports(api, "") {
+			return fmt.Errorf("%s is not supported by the Cosmic API (version %s): "+
+				"the %s command is not available", name, cs.capabilities.Version, api)
+		}
+
+		for arg, api := range argumentAPIs[name] {
+			if v, ok := d.GetOk(arg); ok && v != "" && !cs.capabilities.supports(api[0], api[1]) {
+				return fmt.Errorf("%s: argument %q is not supported by the Cosmic API (version %s)",
+					name, arg, cs.capabilities.Version)
+			}
+		}
+
+		if tags, ok := d.GetOk("tags"); ok && len(tags.(map[string]interface{})) > 0 &&
+			!cs.capabilities.supports("createTags", "") {
+			return fmt.Errorf("%s: argument \"tags\" is not supported by the Cosmic API (version %s)",
+				name, cs.capabilities.Version)
+		}
+
+		if f != nil {
+			return f(d, meta)
+		}
+
+		return nil
+	}
+}
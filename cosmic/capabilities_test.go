@@ -0,0 +1,67 @@
+package cosmic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCapabilitiesSupports(t *testing.T) {
+	var unknown *capabilities
+	if !unknown.supports("createVPC", "sourcenatlist") {
+		t.Fatal("expected unknown capabilities to support everything")
+	}
+
+	c := &capabilities{
+		APIs: map[string]map[string]bool{
+			"createVPC": {"name": true, "sourcenatlist": true},
+		},
+	}
+
+	cases := []struct {
+		api      string
+		param    string
+		expected bool
+	}{
+		{"createVPC", "", true},
+		{"createVPC", "sourcenatlist", true},
+		{"createVPC", "syslogserverlist", false},
+		{"createNetwork", "", false},
+	}
+
+	for _, tc := range cases {
+		if got := c.supports(tc.api, tc.param); got != tc.expected {
+			t.Fatalf("supports(%q, %q): expected %t, got %t", tc.api, tc.param, tc.expected, got)
+		}
+	}
+}
+
+func TestConfigListAPIs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("command") != "listApis" {
+			t.Errorf("unexpected command: %s", r.URL.Query().Get("command"))
+		}
+		fmt.Fprint(w, `{"listapisresponse":{"count":1,"api":[{"name":"createVPC","params":[{"name":"sourcenatlist"}]}]}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	apis, err := c.listAPIs(cs)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	caps := &capabilities{APIs: apis}
+	if !caps.supports("createVPC", "sourcenatlist") {
+		t.Fatalf("expected createVPC with sourcenatlist to be supported: %v", apis)
+	}
+	if caps.supports("createVPC", "syslogserverlist") {
+		t.Fatalf("expected createVPC with syslogserverlist not to be supported: %v", apis)
+	}
+}
@@ -1,21 +1,326 @@
 package cosmic
 
-import "github.com/MissionCriticalCloud/go-cosmic/cosmic"
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// defaultAsyncTimeout is the default timeout of the operations that wait for
+// async jobs. When the provider is configured, these defaults are replaced by
+// the provider timeout, see asyncTimeouts.
+const defaultAsyncTimeout = 15 * time.Minute
 
 // Config is the configuration structure used to instantiate a
 // new Cosmic client.
 type Config struct {
-	APIURL      string
-	APIKey      string
-	SecretKey   string
-	HTTPGETOnly bool
-	Timeout     int64
+	APIURL          string
+	APIKey          string
+	SecretKey       string
+	HTTPGETOnly     bool
+	Timeout         int64
+	DefaultTags     map[string]string
+	DefaultZone     string
+	DefaultProject  string
+	MaxRetries      int
+	RetryMaxBackoff time.Duration
+	CACert          string
+	ClientCert      string
+	ClientKey       string
+	Insecure        bool
+
+	// HTTP client settings
+	HTTPTimeout           int64
+	MaxIdleConnections    int
+	IdleConnectionTimeout int64
+	KeepAlive             bool
+
+	// Client-side API limits
+	MaxRequestsPerSecond  float64
+	MaxConcurrentRequests int
+
+	// Log all API calls, also when TF_LOG is not set to TRACE
+	DebugAPICalls bool
+
+	// Cache the IDs of offerings, zones, projects and templates
+	LookupCache    bool
+	LookupCacheTTL time.Duration
+
+	transport   *http.Transport
+	limiter     *limitedTransport
+	httpClient  *http.Client
+	lookupCache *lookupCache
+}
+
+// Client is passed as meta to all resources and data sources. It embeds the
+// Cosmic client and holds the state that is shared by all resources.
+type Client struct {
+	*cosmic.CosmicClient
+
+	// noWait doesn't wait for async jobs to finish, so operations can wait
+	// for their jobs using their own timeout, see waitForJob
+	noWait *cosmic.CosmicClient
+
+	config       *Config
+	capabilities *capabilities
 }
 
 // NewClient returns a new Cosmic client.
-func (c *Config) NewClient() (*cosmic.CosmicClient, error) {
-	cs := cosmic.NewAsyncClient(c.APIURL, c.APIKey, c.SecretKey, nil, 60)
+func (c *Config) NewClient() (*Client, error) {
+	tlsConfig, err := c.buildTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Both clients share a single transport, so connections are reused
+	c.transport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConns:        c.MaxIdleConnections,
+		MaxIdleConnsPerHost: c.MaxIdleConnections,
+		IdleConnTimeout:     time.Duration(c.IdleConnectionTimeout) * time.Second,
+		DisableKeepAlives:   !c.KeepAlive,
+	}
+
+	var transport http.RoundTripper = c.transport
+	if c.DebugAPICalls || logging.LogLevel() == "TRACE" {
+		transport = &loggingTransport{transport: transport}
+	}
+	c.limiter = newLimitedTransport(transport, c.MaxRequestsPerSecond, c.MaxConcurrentRequests)
+
+	c.httpClient = &http.Client{
+		Transport: c.limiter,
+		Timeout:   time.Duration(c.HTTPTimeout) * time.Second,
+	}
+
+	if c.LookupCache {
+		c.lookupCache = newLookupCache(c.LookupCacheTTL)
+	}
+
+	cs := cosmic.NewAsyncClient(c.APIURL, c.APIKey, c.SecretKey, nil, c.HTTPTimeout, cosmic.WithHTTPClient(c.httpClient))
 	cs.HTTPGETOnly = c.HTTPGETOnly
 	cs.AsyncTimeout(c.Timeout)
-	return cs, nil
+
+	noWait := cosmic.NewClient(c.APIURL, c.APIKey, c.SecretKey, nil, c.HTTPTimeout, cosmic.WithHTTPClient(c.httpClient))
+	noWait.HTTPGETOnly = c.HTTPGETOnly
+
+	return &Client{CosmicClient: cs, noWait: noWait, config: c}, nil
+}
+
+// useAccountAPILimit limits the rate of requests to the part of the API limit
+// of the account that is left in the current interval, if the Cosmic API has
+// API limits enabled. The API limit is read again when the interval expires,
+// so the rate follows the limit of every next interval as well.
+func (c *Config) useAccountAPILimit(cs *Client) {
+	l, err := cs.Limit.GetApiLimit(cs.Limit.NewGetApiLimitParams())
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the API limit: %s", err)
+		return
+	}
+
+	expireAfter := time.Duration(l.ExpireAfter) * time.Millisecond
+
+	rate := accountAPIRate(l.ApiAllowed, expireAfter)
+	if rate <= 0 || c.limiter == nil {
+		return
+	}
+
+	log.Printf("[DEBUG] Limiting the rate of API requests to %.2f per second for %s", rate, expireAfter)
+	c.limiter.setRate(rate)
+
+	time.AfterFunc(expireAfter, func() { c.useAccountAPILimit(cs) })
+}
+
+// accountAPIRate returns the number of requests per second that spreads the
+// requests still allowed by the API limit over the time until the current
+// interval expires. When no requests are allowed anymore, a single request is
+// spread over that time, so requests are held back until the interval expires.
+func accountAPIRate(allowed int, expireAfter time.Duration) float64 {
+	if expireAfter <= 0 {
+		return 0
+	}
+
+	if allowed < 1 {
+		allowed = 1
+	}
+
+	return float64(allowed) / expireAfter.Seconds()
+}
+
+// rawRequest calls an API command, or passes parameters, that go-cosmic doesn't
+// implement. It returns the response without its outer object, just like the
+// go-cosmic services do internally.
+func (cs *Client) rawRequest(command string, params url.Values) (json.RawMessage, error) {
+	c := cs.config
+
+	params.Set("apiKey", c.APIKey)
+	params.Set("command", command)
+	params.Set("response", "json")
+
+	resp, err := c.httpClient.Get(c.APIURL + "?" + signParams(params, c.SecretKey))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = rawValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		e := &APIError{}
+		if err := json.Unmarshal(b, e); err != nil {
+			return nil, err
+		}
+		return nil, e
+	}
+
+	return b, nil
+}
+
+// rawValue returns the value of a JSON object holding a single value, like
+// the responses and async job results of the Cosmic API.
+func rawValue(b json.RawMessage) (json.RawMessage, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for _, v := range m {
+		return v, nil
+	}
+	return nil, fmt.Errorf("Unable to extract the raw value from: %s", string(b))
+}
+
+// signParams returns the encoded parameters including their signature, the
+// same way go-cosmic signs requests.
+func signParams(params url.Values, secret string) string {
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var ps []string
+	for _, k := range keys {
+		ps = append(ps, k+"="+url.QueryEscape(params.Get(k)))
+	}
+	s := strings.Join(ps, "&")
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(strings.Replace(strings.ToLower(s), "+", "%20", -1)))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return s + "&signature=" + url.QueryEscape(signature)
+}
+
+// buildTLSConfig returns the TLS configuration for the configured CA and
+// client certificates, or nil if the default TLS configuration can be used.
+func (c *Config) buildTLSConfig() (*tls.Config, error) {
+	if c.CACert == "" && c.ClientCert == "" && !c.Insecure {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(c.CACert)) {
+			return nil, errors.New("Error parsing CA certificate: no valid certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// asyncTimeouts returns the default timeouts of the resource that wait for
+// async jobs, so they can be set to the provider timeout.
+func asyncTimeouts(r *schema.Resource) []*time.Duration {
+	if r.Timeouts == nil {
+		return nil
+	}
+
+	var timeouts []*time.Duration
+	for _, t := range []*time.Duration{r.Timeouts.Create, r.Timeouts.Update, r.Timeouts.Delete} {
+		if t != nil && *t == defaultAsyncTimeout {
+			timeouts = append(timeouts, t)
+		}
+	}
+
+	return timeouts
+}
+
+// asyncTimeout returns the number of seconds the given operation waits for
+// async jobs to finish.
+func asyncTimeout(d *schema.ResourceData, key string) int64 {
+	return int64(d.Timeout(key) / time.Second)
+}
+
+// waitForJob waits at most timeout seconds for the async job to finish. If r
+// is not nil, the object returned by the job is decoded into r.
+func (cs *Client) waitForJob(jobid string, timeout int64, r interface{}) error {
+	b, err := cs.GetAsyncJobResult(jobid, timeout)
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		return nil
+	}
+
+	b, err = rawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// waitForCreate waits like waitForJob for the async job creating the resource
+// with the given ID. If the job doesn't finish within the timeout, the ID is
+// set anyway, so a resource that is still being created is not lost.
+func (cs *Client) waitForCreate(d *schema.ResourceData, id, jobid string, timeout int64, r interface{}) error {
+	err := cs.waitForJob(jobid, timeout, r)
+	if err == cosmic.AsyncTimeoutErr && id != "" {
+		d.SetId(id)
+	}
+	return err
 }
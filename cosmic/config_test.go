@@ -0,0 +1,367 @@
+package cosmic
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestConfigBuildTLSConfig(t *testing.T) {
+	cert, key := testGenerateCertificate(t)
+
+	c := &Config{}
+	tlsConfig, err := c.buildTLSConfig()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if tlsConfig != nil {
+		t.Fatal("expected the default TLS configuration")
+	}
+
+	c = &Config{CACert: cert, ClientCert: cert, ClientKey: key, Insecure: true}
+	tlsConfig, err = c.buildTLSConfig()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Fatal("expected InsecureSkipVerify to be true")
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Fatal("expected the CA certificate to be set")
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("bad number of client certificates: %d", len(tlsConfig.Certificates))
+	}
+
+	c = &Config{CACert: "invalid"}
+	if _, err := c.buildTLSConfig(); err == nil {
+		t.Fatal("expected an error for an invalid CA certificate")
+	}
+
+	c = &Config{ClientCert: cert, ClientKey: "invalid"}
+	if _, err := c.buildTLSConfig(); err == nil {
+		t.Fatal("expected an error for an invalid client key")
+	}
+}
+
+func TestConfigNewClient(t *testing.T) {
+	c := &Config{
+		APIURL:             "https://cosmic.example.com/client/api",
+		HTTPTimeout:        120,
+		MaxIdleConnections: 20,
+		KeepAlive:          true,
+		Insecure:           true,
+	}
+
+	_, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c.httpClient.Timeout != 120*time.Second {
+		t.Fatalf("bad HTTP timeout: %s", c.httpClient.Timeout)
+	}
+	if c.httpClient.Transport != c.limiter {
+		t.Fatal("expected the client to use the configured transport")
+	}
+	if c.transport.MaxIdleConnsPerHost != 20 || !c.transport.TLSClientConfig.InsecureSkipVerify {
+		t.Fatalf("bad transport: %#v", c.transport)
+	}
+}
+
+func TestConfigNewClientTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"listzonesresponse":{"count":0}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Record the commands passing the transport shared by all clients
+	var commands []string
+	c.limiter.transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		commands = append(commands, req.URL.Query().Get("command"))
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(commands) != 1 || commands[0] != "listZones" {
+		t.Fatalf("expected the API call to use the configured transport, got: %v", commands)
+	}
+}
+
+// roundTripperFunc is a http.RoundTripper calling itself.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testGenerateCertificate(t *testing.T) (string, string) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cosmic"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return string(cert), string(key)
+}
+
+func TestSignParams(t *testing.T) {
+	params := url.Values{}
+	params.Set("response", "json")
+	params.Set("command", "listApis")
+	params.Set("apiKey", "key")
+
+	expected := "apiKey=key&command=listApis&response=json&signature=D86vC%2B2TNoSqwuYQXehnWDxeUuM%3D"
+	if got := signParams(params, "secret"); got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestClientRawRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("name") != "listZones" {
+			w.WriteHeader(431)
+			fmt.Fprint(w, `{"listapisresponse":{"errorcode":431,"cserrorcode":9999,"errortext":"Unknown API"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"listapisresponse":{"count":1}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b, err := cs.rawRequest("listApis", url.Values{"name": {"listZones"}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(b) != `{"count":1}` {
+		t.Fatalf("bad response: %s", b)
+	}
+
+	_, err = cs.rawRequest("listApis", url.Values{"name": {"unknown"}})
+	e, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an API error, got: %v", err)
+	}
+	if e.ErrorCode != 431 || e.Message != "Unknown API" {
+		t.Fatalf("bad API error: %#v", e)
+	}
+}
+
+func TestClientWaitForJob(t *testing.T) {
+	var queries int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("command") {
+		case "createVolume":
+			fmt.Fprint(w, `{"createvolumeresponse":{"id":"vol-1","jobid":"job-1"}}`)
+		case "queryAsyncJobResult":
+			queries++
+			fmt.Fprint(w, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":1,`+
+				`"jobresult":{"volume":{"id":"vol-1","name":"terraform-disk"}}}}`)
+		}
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	r, err := cs.noWait.Volume.CreateVolume(cs.Volume.NewCreateVolumeParams())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if r.Id != "vol-1" || r.JobID != "job-1" {
+		t.Fatalf("bad response: %#v", r)
+	}
+	if queries != 0 {
+		t.Fatal("expected the no wait client to not wait for the job")
+	}
+
+	if err := cs.waitForJob(r.JobID, 10, r); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if r.Name != "terraform-disk" {
+		t.Fatalf("bad job result: %#v", r)
+	}
+	if queries != 1 {
+		t.Fatalf("bad number of job queries: %d", queries)
+	}
+}
+
+func TestClientWaitForCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The job is still running
+		fmt.Fprint(w, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":0}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d := resourceCosmicDisk().Data(nil)
+
+	// A negative timeout expires after the first job query
+	if err := cs.waitForCreate(d, "vol-1", "job-1", -1, nil); err != cosmic.AsyncTimeoutErr {
+		t.Fatalf("expected a timeout, got: %v", err)
+	}
+	if d.Id() != "vol-1" {
+		t.Fatalf("expected the ID to be set, got: %q", d.Id())
+	}
+}
+
+func TestAsyncTimeouts(t *testing.T) {
+	r := &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
+	}
+
+	timeouts := asyncTimeouts(r)
+	if len(timeouts) != 2 {
+		t.Fatalf("expected 2 async timeouts, got: %d", len(timeouts))
+	}
+
+	// Set the defaults to a provider timeout of 10 minutes
+	for _, timeout := range timeouts {
+		*timeout = 10 * time.Minute
+	}
+
+	cases := []struct {
+		Config map[string]interface{}
+		Create time.Duration
+		Update time.Duration
+	}{
+		// Nothing configured, so the provider timeout is used
+		{
+			Config: map[string]interface{}{},
+			Create: 10 * time.Minute,
+			Update: 30 * time.Minute,
+		},
+		// A configured timeout equal to the former default is kept
+		{
+			Config: map[string]interface{}{
+				"timeouts": []map[string]interface{}{{"create": "15m"}},
+			},
+			Create: 15 * time.Minute,
+			Update: 30 * time.Minute,
+		},
+	}
+
+	for i, tc := range cases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		rt := &schema.ResourceTimeout{}
+		if err := rt.ConfigDecode(r, terraform.NewResourceConfig(rc)); err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		if *rt.Create != tc.Create {
+			t.Fatalf("%d: bad create timeout: %s (expected %s)", i, *rt.Create, tc.Create)
+		}
+		if *rt.Update != tc.Update {
+			t.Fatalf("%d: bad update timeout: %s (expected %s)", i, *rt.Update, tc.Update)
+		}
+	}
+}
+
+func TestAccountAPIRate(t *testing.T) {
+	cases := []struct {
+		Allowed     int
+		ExpireAfter time.Duration
+		Rate        float64
+	}{
+		{Allowed: 3600, ExpireAfter: time.Hour, Rate: 1},
+		{Allowed: 10, ExpireAfter: 400 * time.Millisecond, Rate: 25},
+		{Allowed: 25, ExpireAfter: 10 * time.Second, Rate: 2.5},
+		{Allowed: 0, ExpireAfter: 4 * time.Second, Rate: 0.25},
+		{Allowed: 25, ExpireAfter: 0, Rate: 0},
+	}
+
+	for i, tc := range cases {
+		if rate := accountAPIRate(tc.Allowed, tc.ExpireAfter); rate != tc.Rate {
+			t.Fatalf("%d: bad rate: %f (expected %f)", i, rate, tc.Rate)
+		}
+	}
+}
+
+func TestConfigUseAccountAPILimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 20 of the 100 requests per minute are left for the next 10 seconds
+		fmt.Fprint(w, `{"getapilimitresponse":{"apiAllowed":20,"apiIssued":80,"expireAfter":10000}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{APIURL: server.URL, APIKey: "key", SecretKey: "secret", HTTPTimeout: 10}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	c.useAccountAPILimit(cs)
+
+	if c.limiter.rate != 2 {
+		t.Fatalf("bad rate: %f", c.limiter.rate)
+	}
+	if c.limiter.inFlight != nil {
+		t.Fatal("expected the number of concurrent requests to not be limited")
+	}
+}
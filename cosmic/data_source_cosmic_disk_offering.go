@@ -0,0 +1,168 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicDiskOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicDiskOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"min_disk_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"smallest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"is_customized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_customized_iops": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"min_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"provisioning_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_tags": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicDiskOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.DiskOffering.NewListDiskOfferingsParams()
+
+	// If there is a name supplied, only list offerings with that exact name
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	l, err := cs.DiskOffering.ListDiskOfferings(p)
+	if err != nil {
+		return fmt.Errorf("Error listing disk offerings: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	minDiskSize := int64(d.Get("min_disk_size").(int))
+
+	var offerings []*cosmic.DiskOffering
+	for _, o := range l.DiskOfferings {
+		if !match(o.Name) || o.Disksize < minDiskSize {
+			continue
+		}
+		offerings = append(offerings, o)
+	}
+
+	if len(offerings) == 0 {
+		return errNoResults
+	}
+
+	if len(offerings) > 1 && !d.Get("smallest").(bool) {
+		return fmt.Errorf(
+			"Your query returned more than one result. Please try a more specific " +
+				"search criteria, or set `smallest` attribute to true.")
+	}
+
+	o := smallestDiskOffering(offerings)
+	log.Printf("[DEBUG] Selected disk offering: %s (%s)", o.Name, o.Id)
+
+	d.SetId(o.Id)
+	d.Set("name", o.Name)
+	d.Set("display_text", o.Displaytext)
+	d.Set("disk_size", int(o.Disksize))
+	d.Set("is_customized", o.Iscustomized)
+	d.Set("is_customized_iops", o.Iscustomizediops)
+	d.Set("min_iops", int(o.Miniops))
+	d.Set("max_iops", int(o.Maxiops))
+	d.Set("provisioning_type", o.Provisioningtype)
+	d.Set("storage_type", o.Storagetype)
+	d.Set("storage_tags", o.Tags)
+
+	return nil
+}
+
+// smallestDiskOffering returns the offering with the smallest disk size. The
+// size of customizable offerings is chosen when creating a disk, so they are
+// only used when no offering with a fixed size matches. Ties are broken by
+// name and ID, so the result doesn't depend on the order the offerings are
+// listed in.
+func smallestDiskOffering(offerings []*cosmic.DiskOffering) *cosmic.DiskOffering {
+	sort.Slice(offerings, func(i, j int) bool {
+		a, b := offerings[i], offerings[j]
+		if a.Iscustomized != b.Iscustomized {
+			return !a.Iscustomized
+		}
+		if a.Disksize != b.Disksize {
+			return a.Disksize < b.Disksize
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Id < b.Id
+	})
+
+	return offerings[0]
+}
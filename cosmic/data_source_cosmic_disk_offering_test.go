@@ -0,0 +1,54 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestSmallestDiskOffering(t *testing.T) {
+	offerings := []*cosmic.DiskOffering{
+		{Id: "1", Name: "custom", Iscustomized: true},
+		{Id: "2", Name: "large", Disksize: 100},
+		{Id: "3", Name: "small-b", Disksize: 20},
+		{Id: "4", Name: "small-a", Disksize: 20},
+	}
+
+	// Try a few orders, the result must not depend on the order of the list
+	for i := 0; i < len(offerings); i++ {
+		l := append(append([]*cosmic.DiskOffering{}, offerings[i:]...), offerings[:i]...)
+		if o := smallestDiskOffering(l); o.Id != "4" {
+			t.Fatalf("%d: bad disk offering: %s", i, o.Id)
+		}
+	}
+
+	// Customizable offerings are only used if nothing else matches
+	if o := smallestDiskOffering(offerings[:1]); o.Id != "1" {
+		t.Fatalf("bad disk offering: %s", o.Id)
+	}
+}
+
+func TestAccCosmicDiskOfferingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicDiskOfferingDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_disk_offering.foo", "name", COSMIC_DISK_OFFERING_1),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_disk_offering.foo", "disk_size"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicDiskOfferingDataSource_basic = fmt.Sprintf(`
+data "cosmic_disk_offering" "foo" {
+  name = "%s"
+}`, COSMIC_DISK_OFFERING_1)
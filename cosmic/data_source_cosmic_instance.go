@@ -0,0 +1,254 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_offering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"keypair": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cpu_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"nic": instanceNICSchema(),
+		},
+	}
+}
+
+func dataSourceCosmicInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.VirtualMachine.NewListVirtualMachinesParams()
+
+	if id, ok := d.GetOk("id"); ok {
+		p.SetId(id.(string))
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list instances having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.VirtualMachine.ListVirtualMachines(p)
+	if err != nil {
+		return fmt.Errorf("Error listing instances: %s", err)
+	}
+
+	// The name parameter also matches partial names, so filter again
+	var vms []*cosmic.VirtualMachine
+	for _, vm := range l.VirtualMachines {
+		if name, ok := d.GetOk("name"); ok && vm.Name != name.(string) {
+			continue
+		}
+		vms = append(vms, vm)
+	}
+
+	if len(vms) == 0 {
+		return errNoResults
+	}
+
+	if len(vms) > 1 {
+		return errMultipleResults
+	}
+
+	vm := vms[0]
+	log.Printf("[DEBUG] Selected instance: %s (%s)", vm.Name, vm.Id)
+
+	d.SetId(vm.Id)
+	d.Set("name", vm.Name)
+	d.Set("display_name", vm.Displayname)
+	d.Set("state", vm.State)
+	d.Set("service_offering", vm.Serviceofferingname)
+	d.Set("template", vm.Templatename)
+	d.Set("group", vm.Group)
+	d.Set("keypair", vm.Keypair)
+	d.Set("cpu_number", vm.Cpunumber)
+	d.Set("memory", vm.Memory)
+	d.Set("project", vm.Project)
+	d.Set("zone", vm.Zonename)
+
+	nics := flattenInstanceNICs(vm)
+	for _, nic := range nics {
+		if nic["is_default"].(bool) {
+			d.Set("network_id", nic["network_id"])
+			d.Set("ip_address", nic["ip_address"])
+		}
+	}
+	d.Set("nic", nics)
+
+	// Read the tags and store them in a map
+	tags := make(map[string]interface{})
+	for item := range vm.Tags {
+		tags[vm.Tags[item].Key] = vm.Tags[item].Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+// instanceNICSchema returns the schema used to export the NICs of an instance
+func instanceNICSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"network_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"ip_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"mac_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"netmask": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"gateway": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"is_default": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// flattenInstanceNICs returns the NICs of an instance in the format used by
+// instanceNICSchema
+func flattenInstanceNICs(vm *cosmic.VirtualMachine) []map[string]interface{} {
+	nics := make([]map[string]interface{}, 0, len(vm.Nic))
+	for _, n := range vm.Nic {
+		nics = append(nics, map[string]interface{}{
+			"id":          n.Id,
+			"network_id":  n.Networkid,
+			"ip_address":  n.Ipaddress,
+			"mac_address": n.Macaddress,
+			"netmask":     n.Netmask,
+			"gateway":     n.Gateway,
+			"is_default":  n.Isdefault,
+		})
+	}
+	return nics
+}
@@ -0,0 +1,60 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicInstanceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicInstanceDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.cosmic_instance.foo", "id", "cosmic_instance.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.cosmic_instance.foo", "ip_address", "cosmic_instance.foo", "ip_address"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instance.foo", "state", "Running"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instance.foo", "nic.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicInstanceDataSource_basic = fmt.Sprintf(`
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform-test"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}
+
+data "cosmic_instance" "foo" {
+  name = "${cosmic_instance.foo.name}"
+}`,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
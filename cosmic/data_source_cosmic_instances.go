@@ -0,0 +1,246 @@
+package cosmic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_offering": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"template": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"nic": instanceNICSchema(),
+
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmicInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.VirtualMachine.NewListVirtualMachinesParams()
+
+	if networkid, ok := d.GetOk("network_id"); ok {
+		p.SetNetworkid(networkid.(string))
+	}
+
+	if vpcid, ok := d.GetOk("vpc_id"); ok {
+		p.SetVpcid(vpcid.(string))
+	}
+
+	if state, ok := d.GetOk("state"); ok {
+		p.SetState(state.(string))
+	}
+
+	// Groups can only be filtered by ID, names are matched below
+	group, hasGroup := d.GetOk("group")
+	if hasGroup && cosmic.IsID(group.(string)) {
+		p.SetGroupid(group.(string))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list instances having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.VirtualMachine.ListVirtualMachines(p)
+	if err != nil {
+		return fmt.Errorf("Error listing instances: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	var vms []*cosmic.VirtualMachine
+	for _, vm := range l.VirtualMachines {
+		if !match(vm.Name) {
+			continue
+		}
+
+		if hasGroup && !cosmic.IsID(group.(string)) && vm.Group != group.(string) {
+			continue
+		}
+
+		vms = append(vms, vm)
+	}
+
+	// Sort the instances by name so the order of the results is stable
+	sort.SliceStable(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+
+	var ids, names, ipAddresses []string
+	var instances []map[string]interface{}
+	for _, vm := range vms {
+		instance := map[string]interface{}{
+			"id":               vm.Id,
+			"name":             vm.Name,
+			"display_name":     vm.Displayname,
+			"state":            vm.State,
+			"service_offering": vm.Serviceofferingname,
+			"template":         vm.Templatename,
+			"group":            vm.Group,
+			"zone":             vm.Zonename,
+		}
+
+		nics := flattenInstanceNICs(vm)
+		for _, nic := range nics {
+			if nic["is_default"].(bool) {
+				instance["network_id"] = nic["network_id"]
+				instance["ip_address"] = nic["ip_address"]
+				ipAddresses = append(ipAddresses, nic["ip_address"].(string))
+			}
+		}
+		instance["nic"] = nics
+
+		ids = append(ids, vm.Id)
+		names = append(names, vm.Name)
+		instances = append(instances, instance)
+	}
+
+	d.SetId(listID(ids))
+
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("ip_addresses", ipAddresses)
+	d.Set("instances", instances)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicInstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicInstancesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instances.foo", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instances.foo", "names.0", "terraform-test-0"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instances.foo", "names.1", "terraform-test-1"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_instances.foo", "instances.0.group", "terraform-group"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicInstancesDataSource_basic = fmt.Sprintf(`
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  count            = 2
+  name             = "terraform-test-${count.index}"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  group            = "terraform-group"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}
+
+data "cosmic_instances" "foo" {
+  network_id = "${cosmic_network.foo.id}"
+  group      = "terraform-group"
+  name_regex = "^terraform-test-"
+  state      = "Running"
+
+  depends_on = ["cosmic_instance.foo"]
+}`,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
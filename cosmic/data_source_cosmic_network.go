@@ -0,0 +1,190 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicNetworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_offering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vlan": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"acl_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_exclusion_list": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Network.NewListNetworksParams()
+
+	if vpcid, ok := d.GetOk("vpc_id"); ok {
+		p.SetVpcid(vpcid.(string))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list networks having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.Network.ListNetworks(p)
+	if err != nil {
+		return fmt.Errorf("Error listing networks: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	// The API cannot filter networks on name or CIDR, so do that here
+	name, hasName := d.GetOk("name")
+	cidr, hasCidr := d.GetOk("cidr")
+
+	var networks []*cosmic.Network
+	for _, n := range l.Networks {
+		if !match(n.Name) || (hasName && n.Name != name.(string)) || (hasCidr && n.Cidr != cidr.(string)) {
+			continue
+		}
+		networks = append(networks, n)
+	}
+
+	if len(networks) == 0 {
+		return errNoResults
+	}
+
+	if len(networks) > 1 {
+		return errMultipleResults
+	}
+
+	n := networks[0]
+	log.Printf("[DEBUG] Selected network: %s (%s)", n.Name, n.Id)
+
+	d.SetId(n.Id)
+	d.Set("name", n.Name)
+	d.Set("display_text", n.Displaytext)
+	d.Set("cidr", n.Cidr)
+	d.Set("gateway", n.Gateway)
+	d.Set("network_domain", n.Networkdomain)
+	d.Set("network_offering", n.Networkofferingname)
+	d.Set("vlan", n.Vlan)
+	d.Set("vpc_id", n.Vpcid)
+	d.Set("ip_exclusion_list", n.Ipexclusionlist)
+	d.Set("state", n.State)
+	d.Set("project", n.Project)
+	d.Set("zone", n.Zonename)
+
+	if n.Aclid == "" {
+		n.Aclid = none
+	}
+	d.Set("acl_id", n.Aclid)
+
+	// Read the tags and store them in a map
+	tags := make(map[string]interface{})
+	for item := range n.Tags {
+		tags[n.Tags[item].Key] = n.Tags[item].Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicNetworkOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicNetworkOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"for_vpc": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"guest_ip_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"supported_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"traffic_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_persistent": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"specify_vlan": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"specify_ip_ranges": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"network_rate": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicNetworkOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.NetworkOffering.NewListNetworkOfferingsParams()
+
+	// If there is a name supplied, only list offerings with that exact name
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	if forVPC, ok := d.GetOkExists("for_vpc"); ok {
+		p.SetForvpc(forVPC.(bool))
+	}
+
+	if guestIPType, ok := d.GetOk("guest_ip_type"); ok {
+		p.SetGuestiptype(guestIPType.(string))
+	}
+
+	// If there are supported services supplied, add them to the parameter struct
+	if services := d.Get("supported_services").(*schema.Set); services.Len() > 0 {
+		var supported []string
+		for _, service := range services.List() {
+			supported = append(supported, service.(string))
+		}
+		p.SetSupportedservices(supported)
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	l, err := cs.NetworkOffering.ListNetworkOfferings(p)
+	if err != nil {
+		return fmt.Errorf("Error listing network offerings: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	var offerings []*cosmic.NetworkOffering
+	for _, o := range l.NetworkOfferings {
+		if match(o.Name) {
+			offerings = append(offerings, o)
+		}
+	}
+
+	if len(offerings) == 0 {
+		return errNoResults
+	}
+
+	if len(offerings) > 1 {
+		return errMultipleResults
+	}
+
+	o := offerings[0]
+	log.Printf("[DEBUG] Selected network offering: %s (%s)", o.Name, o.Id)
+
+	d.SetId(o.Id)
+	d.Set("name", o.Name)
+	d.Set("display_text", o.Displaytext)
+	d.Set("for_vpc", o.Forvpc)
+	d.Set("guest_ip_type", o.Guestiptype)
+	d.Set("traffic_type", o.Traffictype)
+	d.Set("availability", o.Availability)
+	d.Set("state", o.State)
+	d.Set("is_default", o.Isdefault)
+	d.Set("is_persistent", o.Ispersistent)
+	d.Set("specify_vlan", o.Specifyvlan)
+	d.Set("specify_ip_ranges", o.Specifyipranges)
+	d.Set("network_rate", o.Networkrate)
+
+	services := &schema.Set{F: schema.HashString}
+	for _, s := range o.Service {
+		services.Add(s.Name)
+	}
+	d.Set("supported_services", services)
+
+	return nil
+}
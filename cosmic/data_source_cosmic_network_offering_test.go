@@ -0,0 +1,34 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicNetworkOfferingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicNetworkOfferingDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_network_offering.foo", "name", COSMIC_VPC_NETWORK_OFFERING),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_network_offering.foo", "for_vpc", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_network_offering.foo", "guest_ip_type"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicNetworkOfferingDataSource_basic = fmt.Sprintf(`
+data "cosmic_network_offering" "foo" {
+  name    = "%s"
+  for_vpc = true
+}`, COSMIC_VPC_NETWORK_OFFERING)
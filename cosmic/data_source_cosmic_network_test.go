@@ -0,0 +1,57 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicNetworkDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicNetworkDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.cosmic_network.foo", "id", "cosmic_network.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_network.foo", "gateway", "10.0.10.1"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_network.foo", "network_offering", COSMIC_VPC_NETWORK_OFFERING),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_network.foo", "tags.terraform-tag", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicNetworkDataSource_basic = fmt.Sprintf(`
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+
+  tags = {
+    terraform-tag = "true"
+  }
+}
+
+data "cosmic_network" "foo" {
+  cidr   = "${cosmic_network.foo.cidr}"
+  vpc_id = "${cosmic_network.foo.vpc_id}"
+
+  tags = {
+    terraform-tag = "true"
+  }
+}`,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE)
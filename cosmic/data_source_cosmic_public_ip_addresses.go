@@ -0,0 +1,224 @@
+package cosmic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicPublicIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicPublicIPAddressesRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_source_nat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"is_static_nat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"public_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"acl_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"is_source_nat": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"is_static_nat": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"virtual_machine_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmicPublicIPAddressesRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.PublicIPAddress.NewListPublicIpAddressesParams()
+
+	if vpcid, ok := d.GetOk("vpc_id"); ok {
+		p.SetVpcid(vpcid.(string))
+	}
+
+	if networkid, ok := d.GetOk("network_id"); ok {
+		p.SetAssociatednetworkid(networkid.(string))
+	}
+
+	if isSourceNAT, ok := d.GetOkExists("is_source_nat"); ok {
+		p.SetIssourcenat(isSourceNAT.(bool))
+	}
+
+	if isStaticNAT, ok := d.GetOkExists("is_static_nat"); ok {
+		p.SetIsstaticnat(isStaticNAT.(bool))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list IP addresses having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.PublicIPAddress.ListPublicIpAddresses(p)
+	if err != nil {
+		return fmt.Errorf("Error listing public IP addresses: %s", err)
+	}
+
+	// The API cannot filter on the associated virtual machine, so do that here
+	virtualmachineid, hasVirtualMachineID := d.GetOk("virtual_machine_id")
+
+	var ips []*cosmic.PublicIpAddress
+	for _, ip := range l.PublicIpAddresses {
+		if hasVirtualMachineID && ip.Virtualmachineid != virtualmachineid.(string) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+
+	// Sort the IP addresses by ID so the order of the results is stable
+	sort.SliceStable(ips, func(i, j int) bool {
+		return ips[i].Id < ips[j].Id
+	})
+
+	var ids, ipAddresses []string
+	var publicIPAddresses []map[string]interface{}
+	for _, ip := range ips {
+		// Read the tags and store them in a map
+		tags := make(map[string]interface{})
+		for item := range ip.Tags {
+			tags[ip.Tags[item].Key] = ip.Tags[item].Value
+		}
+
+		ids = append(ids, ip.Id)
+		ipAddresses = append(ipAddresses, ip.Ipaddress)
+		publicIPAddresses = append(publicIPAddresses, map[string]interface{}{
+			"id":                 ip.Id,
+			"ip_address":         ip.Ipaddress,
+			"acl_id":             ip.Aclid,
+			"state":              ip.State,
+			"is_source_nat":      ip.Issourcenat,
+			"is_static_nat":      ip.Isstaticnat,
+			"network_id":         ip.Associatednetworkid,
+			"vpc_id":             ip.Vpcid,
+			"virtual_machine_id": ip.Virtualmachineid,
+			"tags":               tags,
+		})
+	}
+
+	d.SetId(listID(ids))
+
+	d.Set("ids", ids)
+	d.Set("ip_addresses", ipAddresses)
+	d.Set("public_ip_addresses", publicIPAddresses)
+
+	return nil
+}
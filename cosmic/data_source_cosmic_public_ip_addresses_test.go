@@ -0,0 +1,34 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicPublicIPAddressesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicPublicIPAddressesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_public_ip_addresses.foo", "ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_public_ip_addresses.foo", "public_ip_addresses.0.vpc_id", COSMIC_VPC_ID),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_public_ip_addresses.foo", "public_ip_addresses.0.is_source_nat", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicPublicIPAddressesDataSource_basic = fmt.Sprintf(`
+data "cosmic_public_ip_addresses" "foo" {
+  vpc_id        = "%s"
+  is_source_nat = true
+}`, COSMIC_VPC_ID)
@@ -0,0 +1,184 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicServiceOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicServiceOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"min_cpu_number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"min_memory": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"smallest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cpu_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"is_customized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_volatile": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"offer_ha": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"limit_cpu_use": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"network_rate": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_tags": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host_tags": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicServiceOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.ServiceOffering.NewListServiceOfferingsParams()
+
+	// If there is a name supplied, only list offerings with that exact name
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	l, err := cs.ServiceOffering.ListServiceOfferings(p)
+	if err != nil {
+		return fmt.Errorf("Error listing service offerings: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	minCPUNumber := d.Get("min_cpu_number").(int)
+	minMemory := d.Get("min_memory").(int)
+
+	var offerings []*cosmic.ServiceOffering
+	for _, o := range l.ServiceOfferings {
+		if !match(o.Name) || o.Cpunumber < minCPUNumber || o.Memory < minMemory {
+			continue
+		}
+		offerings = append(offerings, o)
+	}
+
+	if len(offerings) == 0 {
+		return errNoResults
+	}
+
+	if len(offerings) > 1 && !d.Get("smallest").(bool) {
+		return fmt.Errorf(
+			"Your query returned more than one result. Please try a more specific " +
+				"search criteria, or set `smallest` attribute to true.")
+	}
+
+	o := smallestServiceOffering(offerings)
+	log.Printf("[DEBUG] Selected service offering: %s (%s)", o.Name, o.Id)
+
+	d.SetId(o.Id)
+	d.Set("name", o.Name)
+	d.Set("display_text", o.Displaytext)
+	d.Set("cpu_number", o.Cpunumber)
+	d.Set("memory", o.Memory)
+	d.Set("is_customized", o.Iscustomized)
+	d.Set("is_volatile", o.Isvolatile)
+	d.Set("offer_ha", o.Offerha)
+	d.Set("limit_cpu_use", o.Limitcpuuse)
+	d.Set("network_rate", o.Networkrate)
+	d.Set("storage_type", o.Storagetype)
+	d.Set("storage_tags", o.Tags)
+	d.Set("host_tags", o.Hosttags)
+
+	return nil
+}
+
+// smallestServiceOffering returns the offering with the fewest CPUs, then the
+// least memory. Ties are broken by name and ID, so the result doesn't depend
+// on the order the offerings are listed in.
+func smallestServiceOffering(offerings []*cosmic.ServiceOffering) *cosmic.ServiceOffering {
+	sort.Slice(offerings, func(i, j int) bool {
+		a, b := offerings[i], offerings[j]
+		if a.Cpunumber != b.Cpunumber {
+			return a.Cpunumber < b.Cpunumber
+		}
+		if a.Memory != b.Memory {
+			return a.Memory < b.Memory
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Id < b.Id
+	})
+
+	return offerings[0]
+}
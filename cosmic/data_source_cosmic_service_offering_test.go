@@ -0,0 +1,50 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestSmallestServiceOffering(t *testing.T) {
+	offerings := []*cosmic.ServiceOffering{
+		{Id: "1", Name: "large", Cpunumber: 4, Memory: 8192},
+		{Id: "2", Name: "medium-b", Cpunumber: 2, Memory: 4096},
+		{Id: "3", Name: "medium-a", Cpunumber: 2, Memory: 8192},
+		{Id: "4", Name: "medium-a", Cpunumber: 2, Memory: 4096},
+		{Id: "5", Name: "medium-a", Cpunumber: 2, Memory: 4096},
+	}
+
+	// Try a few orders, the result must not depend on the order of the list
+	for i := 0; i < len(offerings); i++ {
+		l := append(append([]*cosmic.ServiceOffering{}, offerings[i:]...), offerings[:i]...)
+		if o := smallestServiceOffering(l); o.Id != "4" {
+			t.Fatalf("%d: bad service offering: %s", i, o.Id)
+		}
+	}
+}
+
+func TestAccCosmicServiceOfferingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicServiceOfferingDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_service_offering.foo", "name", COSMIC_SERVICE_OFFERING_1),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_service_offering.foo", "cpu_number"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicServiceOfferingDataSource_basic = fmt.Sprintf(`
+data "cosmic_service_offering" "foo" {
+  name = "%s"
+}`, COSMIC_SERVICE_OFFERING_1)
@@ -0,0 +1,203 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"template_filter": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hypervisor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"is_ready": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Template.NewListTemplatesParams(d.Get("template_filter").(string))
+
+	// If there is a name supplied, only list templates with that exact name
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list templates having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.Template.ListTemplates(p)
+	if err != nil {
+		return fmt.Errorf("Error listing templates: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	// Filter the templates on their name if a name regex is supplied
+	var templates []*cosmic.Template
+	for _, t := range l.Templates {
+		if match(t.Name) {
+			templates = append(templates, t)
+		}
+	}
+
+	if len(templates) == 0 {
+		return errNoResults
+	}
+
+	if len(templates) > 1 && !d.Get("most_recent").(bool) {
+		return fmt.Errorf(
+			"Your query returned more than one result. Please try a more specific " +
+				"search criteria, or set `most_recent` attribute to true.")
+	}
+
+	t, err := mostRecentTemplate(templates)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Selected template: %s (%s)", t.Name, t.Id)
+
+	return dataSourceCosmicTemplateAttributes(d, t)
+}
+
+func dataSourceCosmicTemplateAttributes(d *schema.ResourceData, t *cosmic.Template) error {
+	d.SetId(t.Id)
+	d.Set("name", t.Name)
+	d.Set("display_text", t.Displaytext)
+	d.Set("format", t.Format)
+	d.Set("hypervisor", t.Hypervisor)
+	d.Set("os_type", t.Ostypename)
+	d.Set("size", int(t.Size/(1024*1024*1024))) // Needed to get GB's again
+	d.Set("is_ready", t.Isready)
+	d.Set("created", t.Created)
+	d.Set("zone", t.Zonename)
+
+	// Read the tags and store them in a map
+	tags := make(map[string]interface{})
+	for item := range t.Tags {
+		tags[t.Tags[item].Key] = t.Tags[item].Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+// mostRecentTemplate returns the template with the latest creation date
+func mostRecentTemplate(templates []*cosmic.Template) (*cosmic.Template, error) {
+	var latest *cosmic.Template
+	var latestCreated time.Time
+
+	for _, t := range templates {
+		created, err := time.Parse(cosmicTimeLayout, t.Created)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse creation date of template %s: %s", t.Name, err)
+		}
+
+		if latest == nil || created.After(latestCreated) {
+			latest = t
+			latestCreated = created
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,63 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestMostRecentTemplate(t *testing.T) {
+	templates := []*cosmic.Template{
+		{Id: "1", Name: "foo", Created: "2019-01-27T10:00:00+0100"},
+		{Id: "2", Name: "foo", Created: "2019-02-03T10:00:00+0100"},
+		{Id: "3", Name: "foo", Created: "2019-01-31T10:00:00+0100"},
+	}
+
+	r, err := mostRecentTemplate(templates)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if r.Id != "2" {
+		t.Fatalf("bad template: %s", r.Id)
+	}
+
+	templates = append(templates, &cosmic.Template{Id: "4", Name: "foo", Created: "invalid"})
+	if _, err := mostRecentTemplate(templates); err == nil {
+		t.Fatal("expected an error for an invalid creation date")
+	}
+}
+
+func TestAccCosmicTemplateDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicTemplateDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_template.foo", "name", COSMIC_TEMPLATE),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_template.foo", "zone", COSMIC_ZONE),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_template.foo", "is_ready", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_template.foo", "os_type"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicTemplateDataSource_basic = fmt.Sprintf(`
+data "cosmic_template" "foo" {
+  template_filter = "executable"
+  name            = "%s"
+  most_recent     = true
+  zone            = "%s"
+}`,
+	COSMIC_TEMPLATE,
+	COSMIC_ZONE)
@@ -0,0 +1,194 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicVPC() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicVPCRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vpc_offering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_nat_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_nat_list": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"syslog_server_list": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicVPCRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.VPC.NewListVPCsParams()
+
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	if cidr, ok := d.GetOk("cidr"); ok {
+		p.SetCidr(cidr.(string))
+	}
+
+	// If there is a zone supplied, we retrieve and set the zone id
+	if zone, ok := d.GetOk("zone"); ok {
+		zoneid, e := retrieveID(cs, "zone", zone.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetZoneid(zoneid)
+	}
+
+	// If there are tags supplied, only list VPCs having all of them
+	if tags, ok := d.GetOk("tags"); ok {
+		p.SetTags(tagsFromSchema(tags.(map[string]interface{})))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	l, err := cs.VPC.ListVPCs(p)
+	if err != nil {
+		return fmt.Errorf("Error listing VPCs: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	// The name parameter also matches partial names, so filter again
+	name, hasName := d.GetOk("name")
+
+	var vpcs []*cosmic.VPC
+	for _, v := range l.VPCs {
+		if !match(v.Name) || (hasName && v.Name != name.(string)) {
+			continue
+		}
+		vpcs = append(vpcs, v)
+	}
+
+	if len(vpcs) == 0 {
+		return errNoResults
+	}
+
+	if len(vpcs) > 1 {
+		return errMultipleResults
+	}
+
+	v := vpcs[0]
+	log.Printf("[DEBUG] Selected VPC: %s (%s)", v.Name, v.Id)
+
+	d.SetId(v.Id)
+	d.Set("name", v.Name)
+	d.Set("display_text", v.Displaytext)
+	d.Set("cidr", v.Cidr)
+	d.Set("vpc_offering", v.Vpcofferingname)
+	d.Set("network_domain", v.Networkdomain)
+	d.Set("source_nat_list", v.Sourcenatlist)
+	d.Set("syslog_server_list", v.Syslogserverlist)
+	d.Set("state", v.State)
+	d.Set("project", v.Project)
+	d.Set("zone", v.Zonename)
+
+	// Read the tags and store them in a map
+	tags := make(map[string]interface{})
+	for item := range v.Tags {
+		tags[v.Tags[item].Key] = v.Tags[item].Value
+	}
+	d.Set("tags", tags)
+
+	// Create a new parameter struct
+	ip := cs.PublicIPAddress.NewListPublicIpAddressesParams()
+	ip.SetVpcid(v.Id)
+	ip.SetIssourcenat(true)
+
+	if v.Projectid != "" {
+		ip.SetProjectid(v.Projectid)
+	}
+
+	// Get the source NAT IP assigned to the VPC
+	ips, err := cs.PublicIPAddress.ListPublicIpAddresses(ip)
+	if err != nil {
+		return err
+	}
+
+	if ips.Count == 1 {
+		d.Set("source_nat_ip", ips.PublicIpAddresses[0].Ipaddress)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicVPCOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicVPCOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"supported_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			// Computed values
+			"display_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_offering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_service_offering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCosmicVPCOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.VPC.NewListVPCOfferingsParams()
+
+	// If there is a name supplied, only list offerings with that exact name
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	// If there are supported services supplied, add them to the parameter struct
+	if services := d.Get("supported_services").(*schema.Set); services.Len() > 0 {
+		var supported []string
+		for _, service := range services.List() {
+			supported = append(supported, service.(string))
+		}
+		p.SetSupportedservices(supported)
+	}
+
+	l, err := cs.VPC.ListVPCOfferings(p)
+	if err != nil {
+		return fmt.Errorf("Error listing VPC offerings: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	var offerings []*cosmic.VPCOffering
+	for _, o := range l.VPCOfferings {
+		if match(o.Name) {
+			offerings = append(offerings, o)
+		}
+	}
+
+	if len(offerings) == 0 {
+		return errNoResults
+	}
+
+	if len(offerings) > 1 {
+		return errMultipleResults
+	}
+
+	o := offerings[0]
+	log.Printf("[DEBUG] Selected VPC offering: %s (%s)", o.Name, o.Id)
+
+	d.SetId(o.Id)
+	d.Set("name", o.Name)
+	d.Set("display_text", o.Displaytext)
+	d.Set("service_offering", o.Serviceofferingname)
+	d.Set("secondary_service_offering", o.Secondaryserviceofferingname)
+	d.Set("state", o.State)
+	d.Set("is_default", o.Isdefault)
+
+	services := &schema.Set{F: schema.HashString}
+	for _, s := range o.Service {
+		services.Add(s.Name)
+	}
+	d.Set("supported_services", services)
+
+	return nil
+}
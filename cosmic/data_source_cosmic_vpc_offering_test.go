@@ -0,0 +1,31 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicVPCOfferingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVPCOfferingDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_vpc_offering.foo", "name", COSMIC_VPC_OFFERING),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_vpc_offering.foo", "state"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicVPCOfferingDataSource_basic = fmt.Sprintf(`
+data "cosmic_vpc_offering" "foo" {
+  name = "%s"
+}`, COSMIC_VPC_OFFERING)
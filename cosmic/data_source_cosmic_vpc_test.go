@@ -0,0 +1,47 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicVPCDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVPCDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.cosmic_vpc.foo", "id", "cosmic_vpc.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_vpc.foo", "cidr", "10.0.10.0/22"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_vpc.foo", "vpc_offering", COSMIC_VPC_OFFERING),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_vpc.foo", "source_nat_ip"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicVPCDataSource_basic = fmt.Sprintf(`
+resource "cosmic_vpc" "foo" {
+  name         = "terraform-vpc"
+  cidr         = "10.0.10.0/22"
+  vpc_offering = "%s"
+  zone         = "%s"
+}
+
+data "cosmic_vpc" "foo" {
+  name = "${cosmic_vpc.foo.name}"
+  cidr = "${cosmic_vpc.foo.cidr}"
+  zone = "${cosmic_vpc.foo.zone}"
+}`,
+	COSMIC_VPC_OFFERING,
+	COSMIC_ZONE)
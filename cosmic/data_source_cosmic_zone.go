@@ -0,0 +1,160 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicZone() *schema.Resource {
+	s := zoneAttributesSchema()
+
+	s["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceCosmicZoneRead,
+		Schema: s,
+	}
+}
+
+func dataSourceCosmicZoneRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Zone.NewListZonesParams()
+
+	if id, ok := d.GetOk("id"); ok {
+		p.SetId(id.(string))
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		p.SetName(name.(string))
+	}
+
+	l, err := cs.Zone.ListZones(p)
+	if err != nil {
+		return fmt.Errorf("Error listing zones: %s", err)
+	}
+
+	if l.Count == 0 {
+		return errNoResults
+	}
+
+	if l.Count > 1 {
+		return errMultipleResults
+	}
+
+	z := l.Zones[0]
+	log.Printf("[DEBUG] Selected zone: %s (%s)", z.Name, z.Id)
+
+	d.SetId(z.Id)
+	for k, v := range flattenZone(z) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+// zoneAttributesSchema returns the schema of the attributes exported for a zone
+func zoneAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"network_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"allocation_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"dns": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"internal_dns": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"guest_cidr_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"local_storage_enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"is_dedicated": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"domain": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// flattenZone returns the attributes of a zone in the format used by
+// zoneAttributesSchema
+func flattenZone(z *cosmic.Zone) map[string]interface{} {
+	var dns, internalDNS []string
+	for _, s := range []string{z.Dns1, z.Dns2} {
+		if s != "" {
+			dns = append(dns, s)
+		}
+	}
+	for _, s := range []string{z.Internaldns1, z.Internaldns2} {
+		if s != "" {
+			internalDNS = append(internalDNS, s)
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                    z.Id,
+		"name":                  z.Name,
+		"description":           z.Description,
+		"network_type":          z.Networktype,
+		"allocation_state":      z.Allocationstate,
+		"dns":                   dns,
+		"internal_dns":          internalDNS,
+		"guest_cidr_address":    z.Guestcidraddress,
+		"local_storage_enabled": z.Localstorageenabled,
+		"is_dedicated":          z.Domainid != "",
+		"domain":                z.Domain,
+	}
+}
@@ -0,0 +1,33 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicZoneDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicZoneDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_zone.foo", "name", COSMIC_ZONE),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_zone.foo", "allocation_state", "Enabled"),
+					resource.TestCheckResourceAttrSet(
+						"data.cosmic_zone.foo", "network_type"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicZoneDataSource_basic = fmt.Sprintf(`
+data "cosmic_zone" "foo" {
+  name = "%s"
+}`, COSMIC_ZONE)
@@ -0,0 +1,111 @@
+package cosmic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCosmicZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCosmicZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"allocation_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: zoneAttributesSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmicZonesRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Zone.NewListZonesParams()
+
+	if networkType, ok := d.GetOk("network_type"); ok {
+		p.SetNetworktype(networkType.(string))
+	}
+
+	l, err := cs.Zone.ListZones(p)
+	if err != nil {
+		return fmt.Errorf("Error listing zones: %s", err)
+	}
+
+	match, err := nameMatcher(d)
+	if err != nil {
+		return err
+	}
+
+	allocationState, hasAllocationState := d.GetOk("allocation_state")
+
+	var zs []*cosmic.Zone
+	for _, z := range l.Zones {
+		if !match(z.Name) {
+			continue
+		}
+
+		if hasAllocationState && !strings.EqualFold(z.Allocationstate, allocationState.(string)) {
+			continue
+		}
+
+		zs = append(zs, z)
+	}
+
+	// Sort the zones by name so the order of the results is stable
+	sort.SliceStable(zs, func(i, j int) bool {
+		return zs[i].Name < zs[j].Name
+	})
+
+	var ids, names []string
+	var zones []map[string]interface{}
+	for _, z := range zs {
+		ids = append(ids, z.Id)
+		names = append(names, z.Name)
+		zones = append(zones, flattenZone(z))
+	}
+
+	d.SetId(listID(ids))
+
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("zones", zones)
+
+	return nil
+}
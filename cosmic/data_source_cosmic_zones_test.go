@@ -0,0 +1,34 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCosmicZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicZonesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.cosmic_zones.foo", "names.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_zones.foo", "names.0", COSMIC_ZONE),
+					resource.TestCheckResourceAttr(
+						"data.cosmic_zones.foo", "zones.0.allocation_state", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCosmicZonesDataSource_basic = fmt.Sprintf(`
+data "cosmic_zones" "foo" {
+  name_regex       = "^%s$"
+  allocation_state = "Enabled"
+}`, COSMIC_ZONE)
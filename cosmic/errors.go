@@ -0,0 +1,72 @@
+package cosmic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// APIError is an error returned by the Cosmic API.
+type APIError struct {
+	ErrorCode   int    `json:"errorcode"`
+	CSErrorCode int    `json:"cserrorcode"`
+	Message     string `json:"errortext"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("Cosmic API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.Message)
+}
+
+// apiErrorFormat matches the errors of synchronous calls formatted by go-cosmic
+var apiErrorFormat = regexp.MustCompile(`(?s)^Cosmic API error (\d+) \(CSExceptionErrorCode: (\d+)\): (.*)$`)
+
+// toAPIError returns the given error as an APIError, if it was returned by
+// the Cosmic API. go-cosmic returns API errors as formatted strings, so the
+// error code and message are parsed from the error.
+func toAPIError(err error) (*APIError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	if e, ok := err.(*APIError); ok {
+		return e, true
+	}
+
+	// Errors of synchronous calls
+	if m := apiErrorFormat.FindStringSubmatch(err.Error()); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		csCode, _ := strconv.Atoi(m[2])
+		return &APIError{ErrorCode: code, CSErrorCode: csCode, Message: m[3]}, true
+	}
+
+	// Failed async jobs return the raw job result
+	e := &APIError{}
+	if json.Unmarshal([]byte(err.Error()), e) == nil && e.ErrorCode != 0 {
+		return e, true
+	}
+
+	return nil, false
+}
+
+// isNotFound returns true if the given error means the entity with the given
+// ID does not exist (anymore), so it can be removed from the state. Errors
+// about other entities, like a project that cannot be found, are not matched.
+func isNotFound(err error, id string) bool {
+	if err == nil || id == "" {
+		return false
+	}
+
+	// Returned by the go-cosmic Get<Entity>ByID helpers
+	if strings.HasPrefix(err.Error(), "No match found for "+id+": ") {
+		return true
+	}
+
+	if e, ok := toAPIError(err); ok {
+		return e.ErrorCode == 431 && strings.Contains(e.Message, "does not exist") &&
+			strings.Contains(e.Message, id)
+	}
+
+	return false
+}
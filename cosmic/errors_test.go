@@ -0,0 +1,110 @@
+package cosmic
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestToAPIError(t *testing.T) {
+	cases := []struct {
+		Err      error
+		APIError *APIError
+	}{
+		{
+			Err: fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Unable to execute API command"),
+			APIError: &APIError{
+				ErrorCode:   431,
+				CSErrorCode: 4350,
+				Message:     "Unable to execute API command",
+			},
+		},
+		{
+			Err: fmt.Errorf(`{"cserrorcode":9999,"errorcode":530,"errortext":"Failed to create network"}`),
+			APIError: &APIError{
+				ErrorCode:   530,
+				CSErrorCode: 9999,
+				Message:     "Failed to create network",
+			},
+		},
+		{
+			Err:      &APIError{ErrorCode: 401, Message: "Unauthorized"},
+			APIError: &APIError{ErrorCode: 401, Message: "Unauthorized"},
+		},
+		{
+			Err:      errors.New("dial tcp: connection refused"),
+			APIError: nil,
+		},
+	}
+
+	for i, tc := range cases {
+		e, ok := toAPIError(tc.Err)
+		if ok != (tc.APIError != nil) || !reflect.DeepEqual(e, tc.APIError) {
+			t.Fatalf("%d: bad API error: %#v (expected %#v)", i, e, tc.APIError)
+		}
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := []struct {
+		Err      error
+		ID       string
+		NotFound bool
+	}{
+		{
+			Err: fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Invalid parameter id " +
+				"value=42 due to incorrect long value format, or entity does not exist"),
+			ID:       "42",
+			NotFound: true,
+		},
+		{
+			Err: fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): A key pair with " +
+				"name 'foo' does not exist for account admin"),
+			ID:       "foo",
+			NotFound: true,
+		},
+		{
+			Err:      fmt.Errorf("No match found for 42: &{Count:0 VirtualMachines:[]}"),
+			ID:       "42",
+			NotFound: true,
+		},
+		{
+			Err:      fmt.Errorf("No match found for 42: &{Count:0 VirtualMachines:[]}"),
+			ID:       "4",
+			NotFound: false,
+		},
+		{
+			Err:      fmt.Errorf("No match found for terraform-project: &{Count:0 Projects:[]}"),
+			ID:       "42",
+			NotFound: false,
+		},
+		{
+			Err: fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Invalid parameter " +
+				"projectid value=43 due to incorrect long value format, or entity does not exist"),
+			ID:       "42",
+			NotFound: false,
+		},
+		{
+			Err:      fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Invalid value for cidr"),
+			ID:       "42",
+			NotFound: false,
+		},
+		{
+			Err:      fmt.Errorf("Cosmic API error 530 (CSExceptionErrorCode: 9999): Entity 42 does not exist"),
+			ID:       "42",
+			NotFound: false,
+		},
+		{
+			Err:      nil,
+			ID:       "42",
+			NotFound: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if n := isNotFound(tc.Err, tc.ID); n != tc.NotFound {
+			t.Fatalf("%d: bad not found: %t (expected %t) for %q", i, n, tc.NotFound, tc.Err)
+		}
+	}
+}
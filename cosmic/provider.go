@@ -2,15 +2,21 @@ package cosmic
 
 import (
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/go-ini/ini"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_url": {
 				Type:          schema.TypeString,
@@ -41,8 +47,8 @@ func Provider() terraform.ResourceProvider {
 
 			"timeout": {
 				Type:        schema.TypeInt,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("COSMIC_TIMEOUT", 900),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_TIMEOUT", nil),
 			},
 
 			"config": {
@@ -58,13 +64,146 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc:   schema.EnvDefaultFunc("COSMIC_PROFILE", nil),
 				ConflictsWith: []string{"api_url", "api_key", "secret_key"},
 			},
+
+			"http_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_HTTP_TIMEOUT", 60),
+			},
+
+			"max_idle_connections": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_MAX_IDLE_CONNECTIONS", 10),
+			},
+
+			"idle_connection_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_IDLE_CONNECTION_TIMEOUT", 90),
+			},
+
+			"keep_alive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_KEEP_ALIVE", true),
+			},
+
+			"max_requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_MAX_REQUESTS_PER_SECOND", nil),
+			},
+
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_MAX_CONCURRENT_REQUESTS", 0),
+			},
+
+			"debug_api_calls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_DEBUG_API_CALLS", false),
+			},
+
+			"lookup_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_LOOKUP_CACHE", true),
+			},
+
+			"lookup_cache_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_LOOKUP_CACHE_TTL", 0),
+			},
+
+			"ca_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("COSMIC_CA_FILE", nil),
+				ConflictsWith: []string{"ca_cert"},
+			},
+
+			"ca_cert": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("COSMIC_CA_CERT", nil),
+				ConflictsWith: []string{"ca_file"},
+			},
+
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_CLIENT_CERT", nil),
+			},
+
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_CLIENT_KEY", nil),
+			},
+
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_INSECURE", false),
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_ZONE", nil),
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_PROJECT", nil),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_MAX_RETRIES", nil),
+			},
+
+			"retry_max_backoff": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("COSMIC_RETRY_MAX_BACKOFF", int(defaultRetryMaxBackoff/time.Second)),
+			},
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"cosmic_disk_offering":       dataSourceCosmicDiskOffering(),
+			"cosmic_instance":            dataSourceCosmicInstance(),
+			"cosmic_instances":           dataSourceCosmicInstances(),
+			"cosmic_network":             dataSourceCosmicNetwork(),
+			"cosmic_network_offering":    dataSourceCosmicNetworkOffering(),
+			"cosmic_public_ip_addresses": dataSourceCosmicPublicIPAddresses(),
+			"cosmic_service_offering":    dataSourceCosmicServiceOffering(),
+			"cosmic_template":            dataSourceCosmicTemplate(),
+			"cosmic_vpc":                 dataSourceCosmicVPC(),
+			"cosmic_vpc_offering":        dataSourceCosmicVPCOffering(),
+			"cosmic_zone":                dataSourceCosmicZone(),
+			"cosmic_zones":               dataSourceCosmicZones(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"cosmic_affinity_group":       resourceCosmicAffinityGroup(),
 			"cosmic_disk":                 resourceCosmicDisk(),
 			"cosmic_instance":             resourceCosmicInstance(),
+			"cosmic_instance_group":       resourceCosmicInstanceGroup(),
 			"cosmic_ipaddress":            resourceCosmicIPAddress(),
+			"cosmic_iso":                  resourceCosmicISO(),
 			"cosmic_loadbalancer_rule":    resourceCosmicLoadBalancerRule(),
 			"cosmic_network":              resourceCosmicNetwork(),
 			"cosmic_network_acl":          resourceCosmicNetworkACL(),
@@ -77,17 +216,53 @@ func Provider() terraform.ResourceProvider {
 			"cosmic_static_nat":           resourceCosmicStaticNAT(),
 			"cosmic_static_route":         resourceCosmicStaticRoute(),
 			"cosmic_template":             resourceCosmicTemplate(),
+			"cosmic_vm_snapshot":          resourceCosmicVMSnapshot(),
+			"cosmic_volume_snapshot":      resourceCosmicVolumeSnapshot(),
 			"cosmic_vpc":                  resourceCosmicVPC(),
 			"cosmic_vpn_connection":       resourceCosmicVPNConnection(),
 			"cosmic_vpn_customer_gateway": resourceCosmicVPNCustomerGateway(),
 			"cosmic_vpn_gateway":          resourceCosmicVPNGateway(),
 		},
+	}
+
+	var timeouts []*time.Duration
+	for name, r := range p.ResourcesMap {
+		// Fail at plan time when a resource is not supported by the API
+		r.CustomizeDiff = customizeCapabilitiesDiff(name, r.CustomizeDiff)
+
+		timeouts = append(timeouts, asyncTimeouts(r)...)
+	}
 
-		ConfigureFunc: providerConfigure,
+	// The timeouts waiting for async jobs default to the provider timeout. The
+	// defaults are changed before any plan is made, so timeouts set in the
+	// timeouts block of a resource still take precedence.
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		cs, err := providerConfigure(d)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range timeouts {
+			*t = time.Duration(cs.config.Timeout) * time.Second
+		}
+
+		// Only show a diff for the default project and zone of the provider if
+		// a resource lives in another project or zone than the default
+		for _, r := range p.ResourcesMap {
+			for _, key := range []string{"project", "zone"} {
+				if s, ok := r.Schema[key]; ok && s.Optional && !s.Computed {
+					s.DiffSuppressFunc = suppressDefaultDiff(cs, key)
+				}
+			}
+		}
+
+		return cs, nil
 	}
+
+	return p
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData) (*Client, error) {
 	apiURL, apiURLOK := d.GetOk("api_url")
 	apiKey, apiKeyOK := d.GetOk("api_key")
 	secretKey, secretKeyOK := d.GetOk("secret_key")
@@ -99,22 +274,34 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		if !(apiURLOK && apiKeyOK && secretKeyOK) {
 			return nil, errors.New("'api_url', 'api_key' and 'secret_key' should all have values")
 		}
-	case configOK, profileOK:
-		if !(configOK && profileOK) {
-			return nil, errors.New("'config' and 'profile' should both have a value")
+	case profileOK:
+		if !configOK {
+			return nil, errors.New("'profile' can only be used together with 'config'")
 		}
-	default:
+	case !configOK:
 		return nil, errors.New(
-			"either 'api_url', 'api_key' and 'secret_key' or 'config' and 'profile' should have values")
+			"either 'api_url', 'api_key' and 'secret_key' or 'config' should have values")
 	}
 
-	if configOK && profileOK {
-		cfg, err := ini.Load(config.(string))
-		if err != nil {
-			return nil, err
-		}
+	// Use the number of attempts of each call, unless max_retries is set
+	maxRetries := -1
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		maxRetries = v.(int)
+	}
+
+	timeout := int64(900)
+	if v, ok := d.GetOk("timeout"); ok {
+		timeout = int64(v.(int))
+	}
 
-		section, err := cfg.GetSection(profile.(string))
+	caFile := d.Get("ca_file").(string)
+	caCert := d.Get("ca_cert").(string)
+	clientCert := d.Get("client_cert").(string)
+	clientKey := d.Get("client_key").(string)
+	insecure := d.Get("insecure").(bool)
+
+	if configOK {
+		section, err := loadProfile(config.(string), profile.(string))
 		if err != nil {
 			return nil, err
 		}
@@ -122,15 +309,139 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		apiURL = section.Key("url").String()
 		apiKey = section.Key("apikey").String()
 		secretKey = section.Key("secretkey").String()
+
+		// TLS options set on the provider take precedence over the profile
+		if caFile == "" && caCert == "" {
+			caFile = section.Key("cafile").String()
+			caCert = section.Key("cacert").String()
+		}
+		if clientCert == "" && clientKey == "" {
+			clientCert = section.Key("clientcert").String()
+			clientKey = section.Key("clientkey").String()
+		}
+		if !insecure && section.HasKey("verifysslcert") {
+			insecure = !section.Key("verifysslcert").MustBool(true)
+		}
+
+		// The timeout set on the provider takes precedence over the profile
+		if _, ok := d.GetOk("timeout"); !ok && section.HasKey("timeout") {
+			timeout = section.Key("timeout").MustInt64(timeout)
+		}
+
+		// Requests are always signed without an expiration date
+		if v := section.Key("signatureversion").String(); v != "" {
+			log.Printf("[DEBUG] Ignoring signatureversion %s of profile %s", v, section.Name())
+		}
+	}
+
+	if caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading CA file %s: %s", caFile, err)
+		}
+		caCert = string(ca)
+	}
+
+	if (clientCert == "") != (clientKey == "") {
+		return nil, errors.New("'client_cert' and 'client_key' should both have a value")
+	}
+
+	clientCert, err := readPEM(clientCert)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading client certificate: %s", err)
+	}
+
+	clientKey, err = readPEM(clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading client key: %s", err)
 	}
 
 	cfg := Config{
-		APIURL:      apiURL.(string),
-		APIKey:      apiKey.(string),
-		SecretKey:   secretKey.(string),
-		HTTPGETOnly: d.Get("http_get_only").(bool),
-		Timeout:     int64(d.Get("timeout").(int)),
+		APIURL:          apiURL.(string),
+		APIKey:          apiKey.(string),
+		SecretKey:       secretKey.(string),
+		HTTPGETOnly:     d.Get("http_get_only").(bool),
+		Timeout:         timeout,
+		DefaultTags:     tagsFromSchema(d.Get("default_tags").(map[string]interface{})),
+		DefaultZone:     d.Get("zone").(string),
+		DefaultProject:  d.Get("project").(string),
+		MaxRetries:      maxRetries,
+		RetryMaxBackoff: time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
+		CACert:          caCert,
+		ClientCert:      clientCert,
+		ClientKey:       clientKey,
+		Insecure:        insecure,
+
+		HTTPTimeout:           int64(d.Get("http_timeout").(int)),
+		MaxIdleConnections:    d.Get("max_idle_connections").(int),
+		IdleConnectionTimeout: int64(d.Get("idle_connection_timeout").(int)),
+		KeepAlive:             d.Get("keep_alive").(bool),
+
+		MaxRequestsPerSecond:  d.Get("max_requests_per_second").(float64),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+
+		DebugAPICalls: d.Get("debug_api_calls").(bool),
+
+		LookupCache:    d.Get("lookup_cache").(bool),
+		LookupCacheTTL: time.Duration(d.Get("lookup_cache_ttl").(int)) * time.Second,
+	}
+
+	cs, err := cfg.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the API limit of the account, unless a limit is configured
+	if _, ok := d.GetOk("max_requests_per_second"); !ok {
+		cfg.useAccountAPILimit(cs)
+	}
+
+	cs.capabilities = cfg.loadCapabilities(cs)
+
+	return cs, nil
+}
+
+// loadProfile returns the section of the given profile in the CloudMonkey
+// config file. If no profile is given, the default profile configured in the
+// [core] section of the config file is used.
+func loadProfile(config, profile string) (*ini.Section, error) {
+	path, err := homedir.Expand(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading config file %s: %s", path, err)
+	}
+
+	if profile == "" {
+		profile = cfg.Section("core").Key("profile").String()
+		if profile == "" {
+			return nil, fmt.Errorf(
+				"No 'profile' configured and no default profile found in the [core] section of %s", path)
+		}
+	}
+
+	section, err := cfg.GetSection(profile)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading profile %s from config file %s: %s", profile, path, err)
+	}
+
+	return section, nil
+}
+
+// readPEM returns the given value if it contains PEM encoded data, or else
+// reads the PEM encoded data from the file the value points to.
+func readPEM(v string) (string, error) {
+	if v == "" || strings.Contains(v, "-----BEGIN") {
+		return v, nil
+	}
+
+	b, err := ioutil.ReadFile(v)
+	if err != nil {
+		return "", err
 	}
 
-	return cfg.NewClient()
+	return string(b), nil
 }
@@ -1,6 +1,7 @@
 package cosmic
 
 import (
+	"io/ioutil"
 	"os"
 	"testing"
 
@@ -95,6 +96,23 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+func testAccPreCheckUser(t *testing.T) {
+	testAccPreCheck(t)
+
+	if v := os.Getenv("COSMIC_USER_API_KEY"); v == "" {
+		t.Fatal("COSMIC_USER_API_KEY must be set for acceptance tests as a non-admin account")
+	}
+	if v := os.Getenv("COSMIC_USER_SECRET_KEY"); v == "" {
+		t.Fatal("COSMIC_USER_SECRET_KEY must be set for acceptance tests as a non-admin account")
+	}
+}
+
+// API key of a non-admin account
+var COSMIC_USER_API_KEY = os.Getenv("COSMIC_USER_API_KEY")
+
+// Secret key of a non-admin account
+var COSMIC_USER_SECRET_KEY = os.Getenv("COSMIC_USER_SECRET_KEY")
+
 // Name of a valid disk offering
 var COSMIC_DISK_OFFERING_1 = os.Getenv("COSMIC_DISK_OFFERING_1")
 
@@ -127,3 +145,53 @@ var COSMIC_ZONE = os.Getenv("COSMIC_ZONE")
 
 // ID of the "default_acl" built-in ACL
 var COSMIC_DEFAULT_ALLOW_ACL_ID = os.Getenv("COSMIC_DEFAULT_ALLOW_ACL_ID")
+
+func TestLoadProfile(t *testing.T) {
+	f, err := ioutil.TempFile("", "cloudmonkey")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	f.WriteString(`[core]
+profile = production
+asyncblock = true
+
+[production]
+url = https://production.example.com/client/api
+apikey = production-key
+secretkey = production-secret
+timeout = 3600
+verifysslcert = false
+signatureversion = 3
+
+[staging]
+url = https://staging.example.com/client/api
+apikey = staging-key
+secretkey = staging-secret
+`)
+	f.Close()
+
+	section, err := loadProfile(f.Name(), "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if section.Key("url").String() != "https://production.example.com/client/api" {
+		t.Fatalf("bad default profile: %s", section.Name())
+	}
+	if section.Key("timeout").MustInt64(0) != 3600 {
+		t.Fatalf("bad timeout: %s", section.Key("timeout").String())
+	}
+
+	section, err = loadProfile(f.Name(), "staging")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if section.Key("apikey").String() != "staging-key" {
+		t.Fatalf("bad profile: %s", section.Name())
+	}
+
+	if _, err := loadProfile(f.Name(), "unknown"); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+}
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -48,7 +46,7 @@ func resourceCosmicAffinityGroup() *schema.Resource {
 }
 
 func resourceCosmicAffinityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 	affinityGroupType := d.Get("type").(string)
@@ -81,17 +79,17 @@ func resourceCosmicAffinityGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmicAffinityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	log.Printf("[DEBUG] Rerieving affinity group %s", d.Get("name").(string))
 
 	// Get the affinity group details
-	ag, count, err := cs.AffinityGroup.GetAffinityGroupByID(
+	ag, _, err := cs.AffinityGroup.GetAffinityGroupByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] Affinity group %s does not longer exist", d.Get("name").(string))
 			d.SetId("")
 			return nil
@@ -109,7 +107,7 @@ func resourceCosmicAffinityGroupRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCosmicAffinityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.AffinityGroup.NewDeleteAffinityGroupParams()
@@ -123,10 +121,7 @@ func resourceCosmicAffinityGroupDelete(d *schema.ResourceData, meta interface{})
 	// Delete the affinity group
 	_, err := cs.AffinityGroup.DeleteAffinityGroup(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
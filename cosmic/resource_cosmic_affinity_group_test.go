@@ -40,7 +40,7 @@ func testAccCheckCosmicAffinityGroupExists(
 			return fmt.Errorf("No affinity group ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		ag, _, err := cs.AffinityGroup.GetAffinityGroupByID(rs.Primary.ID)
 
 		if err != nil {
@@ -78,7 +78,7 @@ func testAccCheckCosmicAffinityGroupAttributes(
 }
 
 func testAccCheckCosmicAffinityGroupDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_affinity_group" {
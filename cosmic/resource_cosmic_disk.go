@@ -2,7 +2,7 @@ package cosmic
 
 import (
 	"fmt"
-	"strings"
+	"log"
 
 	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +17,12 @@ func resourceCosmicDisk() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -27,7 +33,19 @@ func resourceCosmicDisk() *schema.Resource {
 
 			"disk_offering": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Don't replace existing or imported disks created from a
+					// snapshot when no snapshot_id is configured
+					return new == ""
+				},
 			},
 
 			"attach": &schema.Schema{
@@ -62,21 +80,24 @@ func resourceCosmicDisk() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
 			"zone": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicDiskCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	d.Partial(true)
 
 	name := d.Get("name").(string)
@@ -85,17 +106,31 @@ func resourceCosmicDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	p := cs.Volume.NewCreateVolumeParams()
 	p.SetName(name)
 
-	// Retrieve the disk_offering ID
-	diskofferingid, e := retrieveID(cs, "disk_offering", d.Get("disk_offering").(string))
-	if e != nil {
-		return e.Error()
-	}
-	// Set the disk_offering ID
-	p.SetDiskofferingid(diskofferingid)
+	if snapshotid, ok := d.GetOk("snapshot_id"); ok {
+		// The disk offering and size are taken from the snapshot
+		if err := verifyDiskSnapshot(cs, d); err != nil {
+			return err
+		}
+
+		// Set the snapshot ID
+		p.SetSnapshotid(snapshotid.(string))
+	} else {
+		if d.Get("disk_offering").(string) == "" {
+			return fmt.Errorf("Error creating the new disk %s: disk_offering is required unless snapshot_id is set", name)
+		}
 
-	if d.Get("size").(int) != 0 {
-		// Set the volume size
-		p.SetSize(int64(d.Get("size").(int)))
+		// Retrieve the disk_offering ID
+		diskofferingid, e := retrieveID(cs, "disk_offering", d.Get("disk_offering").(string))
+		if e != nil {
+			return e.Error()
+		}
+		// Set the disk_offering ID
+		p.SetDiskofferingid(diskofferingid)
+
+		if d.Get("size").(int) != 0 {
+			// Set the volume size
+			p.SetSize(int64(d.Get("size").(int)))
+		}
 	}
 
 	// If there is a project supplied, we retrieve and set the project id
@@ -111,24 +146,51 @@ func resourceCosmicDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	// Set the zone ID
 	p.SetZoneid(zoneid)
 
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
 	// Create the new volume
-	r, err := cs.Volume.CreateVolume(p)
+	r, err := cs.noWait.Volume.CreateVolume(p)
+	if err == nil {
+		err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating the new disk %s: %s", name, err)
 	}
 
+	// Grow a volume created from a snapshot to the configured size
+	if size := int64(d.Get("size").(int)); d.Get("snapshot_id").(string) != "" && size > r.Size/(1024*1024*1024) {
+		rp := cs.Volume.NewResizeVolumeParams(r.Id)
+		rp.SetSize(size)
+
+		rr, err := cs.noWait.Volume.ResizeVolume(rp)
+		if err == nil {
+			err = cs.waitForJob(rr.JobID, timeout, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("Error resizing the new disk %s: %s", name, err)
+		}
+	}
+
 	// Set the volume ID and partials
 	d.SetId(r.Id)
 	d.SetPartial("name")
 	d.SetPartial("device_id")
 	d.SetPartial("disk_offering")
+	d.SetPartial("snapshot_id")
 	d.SetPartial("size")
 	d.SetPartial("virtual_machine_id")
 	d.SetPartial("project")
 	d.SetPartial("zone")
 
+	err = setTags(cs, d, "Volume")
+	if err != nil {
+		return fmt.Errorf("Error setting tags on the new disk %s: %s", name, err)
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	if d.Get("attach").(bool) {
-		err := resourceCosmicDiskAttach(d, meta)
+		err := resourceCosmicDiskAttach(d, meta, timeout)
 		if err != nil {
 			return fmt.Errorf("Error attaching the new disk %s to virtual machine: %s", name, err)
 		}
@@ -142,15 +204,15 @@ func resourceCosmicDiskCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicDiskRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the volume details
-	v, count, err := cs.Volume.GetVolumeByID(
+	v, _, err := cs.Volume.GetVolumeByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			d.SetId("")
 			return nil
 		}
@@ -162,6 +224,8 @@ func resourceCosmicDiskRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("attach", v.Attached != "")           // If attached this contains a timestamp when attached
 	d.Set("size", int(v.Size/(1024*1024*1024))) // Needed to get GB's again
 
+	d.Set("snapshot_id", v.Snapshotid)
+
 	setValueOrID(d, "disk_offering", v.Diskofferingname, v.Diskofferingid)
 	setValueOrID(d, "project", v.Project, v.Projectid)
 	setValueOrID(d, "zone", v.Zonename, v.Zoneid)
@@ -171,15 +235,23 @@ func resourceCosmicDiskRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("virtual_machine_id", v.Virtualmachineid)
 	}
 
+	tags, err := getTags(cs, d, "Volume")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
 func resourceCosmicDiskUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	d.Partial(true)
 
 	name := d.Get("name").(string)
 
+	timeout := asyncTimeout(d, schema.TimeoutUpdate)
+
 	if d.HasChange("disk_offering") || d.HasChange("size") {
 		// Create a new parameter struct
 		p := cs.Volume.NewResizeVolumeParams(d.Id())
@@ -202,7 +274,10 @@ func resourceCosmicDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		p.SetShrinkok(d.Get("shrink_ok").(bool))
 
 		// Change the disk_offering
-		r, err := cs.Volume.ResizeVolume(p)
+		r, err := cs.noWait.Volume.ResizeVolume(p)
+		if err == nil {
+			err = cs.waitForJob(r.JobID, timeout, r)
+		}
 		if err != nil {
 			return fmt.Errorf("Error changing disk offering/size for disk %s: %s", name, err)
 		}
@@ -213,18 +288,28 @@ func resourceCosmicDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("size")
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "Volume")
+		if err != nil {
+			return fmt.Errorf("Error updating tags for disk %s: %s", name, err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
 	// If the device ID changed, just detach here so we can re-attach the
 	// volume at the end of this function
 	if d.HasChange("device_id") || d.HasChange("virtual_machine") {
 		// Detach the volume
-		if err := resourceCosmicDiskDetach(d, meta); err != nil {
+		if err := resourceCosmicDiskDetach(d, meta, timeout); err != nil {
 			return fmt.Errorf("Error detaching disk %s from virtual machine: %s", name, err)
 		}
 	}
 
 	if d.Get("attach").(bool) {
 		// Attach the volume
-		err := resourceCosmicDiskAttach(d, meta)
+		err := resourceCosmicDiskAttach(d, meta, timeout)
 		if err != nil {
 			return fmt.Errorf("Error attaching disk %s to virtual machine: %s", name, err)
 		}
@@ -235,7 +320,7 @@ func resourceCosmicDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("virtual_machine_id")
 	} else {
 		// Detach the volume
-		if err := resourceCosmicDiskDetach(d, meta); err != nil {
+		if err := resourceCosmicDiskDetach(d, meta, timeout); err != nil {
 			return fmt.Errorf("Error detaching disk %s from virtual machine: %s", name, err)
 		}
 	}
@@ -245,10 +330,10 @@ func resourceCosmicDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Detach the volume
-	if err := resourceCosmicDiskDetach(d, meta); err != nil {
+	if err := resourceCosmicDiskDetach(d, meta, asyncTimeout(d, schema.TimeoutDelete)); err != nil {
 		return err
 	}
 
@@ -257,10 +342,7 @@ func resourceCosmicDiskDelete(d *schema.ResourceData, meta interface{}) error {
 
 	// Delete the voluem
 	if _, err := cs.Volume.DeleteVolume(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -270,8 +352,8 @@ func resourceCosmicDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCosmicDiskAttach(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+func resourceCosmicDiskAttach(d *schema.ResourceData, meta interface{}, timeout int64) error {
+	cs := meta.(*Client)
 
 	if virtualmachineid, ok := d.GetOk("virtual_machine_id"); ok {
 		// First check if the disk isn't already attached
@@ -287,7 +369,7 @@ func resourceCosmicDiskAttach(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		// Attach the new volume
-		r, err := Retry(10, retryableAttachVolumeFunc(cs, p))
+		r, err := Retry(cs, 10, retryableAttachVolumeFunc(cs, p, timeout))
 		if err != nil {
 			return fmt.Errorf("Error attaching volume to VM: %s", err)
 		}
@@ -298,8 +380,8 @@ func resourceCosmicDiskAttach(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCosmicDiskDetach(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+func resourceCosmicDiskDetach(d *schema.ResourceData, meta interface{}, timeout int64) error {
+	cs := meta.(*Client)
 
 	// Check if the volume is actually attached, before detaching
 	if attached, err := isAttached(d, meta); err != nil || !attached {
@@ -313,19 +395,30 @@ func resourceCosmicDiskDetach(d *schema.ResourceData, meta interface{}) error {
 	p.SetId(d.Id())
 
 	// Detach the currently attached volume
-	_, err := cs.Volume.DetachVolume(p)
+	r, err := cs.noWait.Volume.DetachVolume(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
 		if virtualmachineid, ok := d.GetOk("virtual_machine_id"); ok {
 			// Create a new parameter struct
 			pd := cs.VirtualMachine.NewStopVirtualMachineParams(virtualmachineid.(string))
 
 			// Stop the virtual machine in order to be able to detach the disk
-			if _, err := cs.VirtualMachine.StopVirtualMachine(pd); err != nil {
+			rd, err := cs.noWait.VirtualMachine.StopVirtualMachine(pd)
+			if err == nil {
+				err = cs.waitForJob(rd.JobID, timeout, nil)
+			}
+			if err != nil {
 				return err
 			}
 
 			// Try again to detach the currently attached volume
-			if _, err := cs.Volume.DetachVolume(p); err != nil {
+			r, err := cs.noWait.Volume.DetachVolume(p)
+			if err == nil {
+				err = cs.waitForJob(r.JobID, timeout, nil)
+			}
+			if err != nil {
 				return err
 			}
 
@@ -333,7 +426,11 @@ func resourceCosmicDiskDetach(d *schema.ResourceData, meta interface{}) error {
 			pu := cs.VirtualMachine.NewStartVirtualMachineParams(virtualmachineid.(string))
 
 			// Start the virtual machine again
-			if _, err := cs.VirtualMachine.StartVirtualMachine(pu); err != nil {
+			ru, err := cs.noWait.VirtualMachine.StartVirtualMachine(pu)
+			if err == nil {
+				err = cs.waitForJob(ru.JobID, timeout, nil)
+			}
+			if err != nil {
 				return err
 			}
 		}
@@ -343,12 +440,12 @@ func resourceCosmicDiskDetach(d *schema.ResourceData, meta interface{}) error {
 }
 
 func isAttached(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the volume details
 	v, _, err := cs.Volume.GetVolumeByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
 		return false, err
@@ -358,13 +455,64 @@ func isAttached(d *schema.ResourceData, meta interface{}) (bool, error) {
 }
 
 func retryableAttachVolumeFunc(
-	cs *cosmic.CosmicClient,
-	p *cosmic.AttachVolumeParams) func() (interface{}, error) {
+	cs *Client,
+	p *cosmic.AttachVolumeParams,
+	timeout int64) func() (interface{}, error) {
 	return func() (interface{}, error) {
-		r, err := cs.Volume.AttachVolume(p)
+		r, err := cs.noWait.Volume.AttachVolume(p)
 		if err != nil {
 			return nil, err
 		}
+		if err := cs.waitForJob(r.JobID, timeout, r); err != nil {
+			return nil, err
+		}
 		return r, nil
 	}
 }
+
+// verifyDiskSnapshot verifies the snapshot a disk is created from is backed up,
+// and that the configured size is not smaller than the snapshot. The disk
+// offering is taken from the snapshot, but the snapshot doesn't report it, so
+// a configured disk offering cannot be verified and is refused.
+func verifyDiskSnapshot(cs *Client, d *schema.ResourceData) error {
+	snapshotid := d.Get("snapshot_id").(string)
+
+	s, _, err := cs.Snapshot.GetSnapshotByID(snapshotid, withProject(cs, d))
+	if err != nil {
+		return fmt.Errorf("Error retrieving snapshot %s: %s", snapshotid, err)
+	}
+
+	if s.State != "BackedUp" {
+		return fmt.Errorf("Snapshot %s is not backed up (state %s)", snapshotid, s.State)
+	}
+
+	// The snapshotted volume is needed to verify the disk offering and size
+	v, _, err := cs.Volume.GetVolumeByID(s.Volumeid, withProject(cs, d))
+	if err != nil {
+		if isNotFound(err, s.Volumeid) {
+			log.Printf("[DEBUG] Volume %s of snapshot %s no longer exists", s.Volumeid, snapshotid)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving volume %s of snapshot %s: %s", s.Volumeid, snapshotid, err)
+	}
+
+	if offering := d.Get("disk_offering").(string); offering != "" {
+		diskofferingid, e := retrieveID(cs, "disk_offering", offering)
+		if e != nil {
+			return e.Error()
+		}
+
+		if diskofferingid != v.Diskofferingid {
+			return fmt.Errorf(
+				"Disk offering %s doesn't match disk offering %s of snapshot %s", offering, v.Diskofferingname, snapshotid)
+		}
+	}
+
+	if size := int64(d.Get("size").(int)); size != 0 && size < v.Size/(1024*1024*1024) {
+		return fmt.Errorf(
+			"Size %d GB is smaller than the %d GB of snapshot %s", size, v.Size/(1024*1024*1024), snapshotid)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -135,6 +136,77 @@ func TestAccCosmicDisk_attachDeviceID(t *testing.T) {
 	})
 }
 
+func TestAccCosmicDisk_snapshot(t *testing.T) {
+	var disk cosmic.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicDisk_snapshot,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicDiskExists(
+						"cosmic_disk.bar", &disk),
+					resource.TestCheckResourceAttrPair(
+						"cosmic_disk.bar", "snapshot_id", "cosmic_volume_snapshot.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"cosmic_disk.bar", "disk_offering", COSMIC_DISK_OFFERING_1),
+					resource.TestCheckResourceAttr(
+						"cosmic_disk.bar", "size", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCosmicDiskSnapshotIDDiff(t *testing.T) {
+	r := resourceCosmicDisk()
+
+	cases := []struct {
+		Config   map[string]interface{}
+		ForceNew bool
+	}{
+		// Created from a snapshot, but no snapshot is configured
+		{
+			Config: map[string]interface{}{"name": "foo"},
+		},
+		// Created from the configured snapshot
+		{
+			Config: map[string]interface{}{"name": "foo", "snapshot_id": "snap-1"},
+		},
+		// Created from another snapshot than the configured snapshot
+		{
+			Config:   map[string]interface{}{"name": "foo", "snapshot_id": "snap-2"},
+			ForceNew: true,
+		},
+	}
+
+	for i, tc := range cases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		state := &terraform.InstanceState{
+			ID: "foo",
+			Attributes: map[string]string{
+				"name":        "foo",
+				"snapshot_id": "snap-1",
+			},
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(rc), nil)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if forceNew := diff != nil && diff.RequiresNew(); forceNew != tc.ForceNew {
+			t.Fatalf("%d: bad force new: %t (expected %t)", i, forceNew, tc.ForceNew)
+		}
+	}
+}
+
 func testAccCheckCosmicDiskExists(
 	n string, disk *cosmic.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -147,7 +219,7 @@ func testAccCheckCosmicDiskExists(
 			return fmt.Errorf("No disk ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		volume, _, err := cs.Volume.GetVolumeByID(rs.Primary.ID)
 
 		if err != nil {
@@ -181,7 +253,7 @@ func testAccCheckCosmicDiskAttributes(
 }
 
 func testAccCheckCosmicDiskDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_disk" {
@@ -328,3 +400,27 @@ resource "cosmic_disk" "foo" {
 	COSMIC_SERVICE_OFFERING_1,
 	COSMIC_TEMPLATE,
 	COSMIC_DISK_OFFERING_1)
+
+var testAccCosmicDisk_snapshot = fmt.Sprintf(`
+resource "cosmic_disk" "foo" {
+  name          = "terraform-disk"
+  attach        = false
+  size          = "10"
+  disk_offering = "%s"
+  zone          = "%s"
+}
+
+resource "cosmic_volume_snapshot" "foo" {
+  volume_id = "${cosmic_disk.foo.id}"
+}
+
+resource "cosmic_disk" "bar" {
+  name        = "terraform-disk-restore"
+  attach      = false
+  size        = "20"
+  snapshot_id = "${cosmic_volume_snapshot.foo.id}"
+  zone        = "%s"
+}`,
+	COSMIC_DISK_OFFERING_1,
+	COSMIC_ZONE,
+	COSMIC_ZONE)
@@ -21,6 +21,12 @@ func resourceCosmicInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,6 +66,11 @@ func resourceCosmicInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"iso": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"root_disk_size": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -91,13 +102,12 @@ func resourceCosmicInstance() *schema.Resource {
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
 			"zone": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
@@ -133,12 +143,16 @@ func resourceCosmicInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Retrieve the service_offering ID
 	serviceofferingid, e := retrieveID(cs, "service_offering", d.Get("service_offering").(string))
@@ -159,7 +173,7 @@ func resourceCosmicInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// Retrieve the template ID
-	templateid, e := retrieveTemplateID(cs, zone.Id, d.Get("template").(string))
+	templateid, e := retrieveTemplateID(cs, zone.Id, getProject(cs, d), d.Get("template").(string))
 	if e != nil {
 		return e.Error()
 	}
@@ -202,8 +216,12 @@ func resourceCosmicInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// If there is a group supplied, add it to the parameter struct
-	if group, ok := d.GetOk("group"); ok {
-		p.SetGroup(group.(string))
+	if _, ok := d.GetOk("group"); ok {
+		group, err := retrieveInstanceGroupName(cs, d)
+		if err != nil {
+			return err
+		}
+		p.SetGroup(group)
 	}
 
 	// If there are affinity group IDs supplied, add them to the parameter struct
@@ -243,14 +261,31 @@ func resourceCosmicInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		p.SetUserdata(ud)
 	}
 
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
 	// Create the new instance
-	r, err := cs.VirtualMachine.DeployVirtualMachine(p)
+	r, err := cs.noWait.VirtualMachine.DeployVirtualMachine(p)
+	if err == nil {
+		err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating the new instance %s: %s", name, err)
 	}
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "UserVm")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	// Attach the ISO, if one is configured
+	if _, ok := d.GetOk("iso"); ok {
+		if err := resourceCosmicInstanceAttachISO(d, meta, timeout); err != nil {
+			return fmt.Errorf("Error attaching ISO to instance %s: %s", name, err)
+		}
+	}
+
 	// Set the connection info for any configured provisioners
 	d.SetConnInfo(map[string]string{
 		"host":     r.Nic[0].Ipaddress,
@@ -261,15 +296,15 @@ func resourceCosmicInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCosmicInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the virtual machine details
-	vm, count, err := cs.VirtualMachine.GetVirtualMachineByID(
+	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] Instance %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
 			return nil
@@ -281,7 +316,7 @@ func resourceCosmicInstanceRead(d *schema.ResourceData, meta interface{}) error
 	// Update the config
 	d.Set("name", vm.Name)
 	d.Set("display_name", vm.Displayname)
-	d.Set("group", vm.Group)
+	setValueOrID(d, "group", vm.Group, vm.Groupid)
 
 	// In some rare cases (when destroying a machine failes) it can happen that
 	// an instance does not have any attached NIC anymore.
@@ -308,17 +343,25 @@ func resourceCosmicInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	setValueOrID(d, "service_offering", vm.Serviceofferingname, vm.Serviceofferingid)
 	setValueOrID(d, "template", vm.Templatename, vm.Templateid)
+	setValueOrID(d, "iso", vm.Isoname, vm.Isoid)
 	setValueOrID(d, "project", vm.Project, vm.Projectid)
 	setValueOrID(d, "zone", vm.Zonename, vm.Zoneid)
 
+	tags, err := getTags(cs, d, "UserVm")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
 func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	d.Partial(true)
 
 	name := d.Get("name").(string)
+	timeout := asyncTimeout(d, schema.TimeoutUpdate)
 
 	// Check if the display name is changed and if so, update the virtual machine
 	if d.HasChange("display_name") {
@@ -348,10 +391,14 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		p := cs.VirtualMachine.NewUpdateVirtualMachineParams(d.Id())
 
 		// Set the new group
-		p.SetGroup(d.Get("group").(string))
+		group, err := retrieveInstanceGroupName(cs, d)
+		if err != nil {
+			return err
+		}
+		p.SetGroup(group)
 
-		// Update the display name
-		_, err := cs.VirtualMachine.UpdateVirtualMachine(p)
+		// Update the group
+		_, err = cs.VirtualMachine.UpdateVirtualMachine(p)
 		if err != nil {
 			return fmt.Errorf(
 				"Error updating the group for instance %s: %s", name, err)
@@ -360,13 +407,56 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("group")
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "UserVm")
+		if err != nil {
+			return fmt.Errorf(
+				"Error updating tags for instance %s: %s", name, err)
+		}
+
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	// Check if the ISO is changed and if so, detach the old and attach the new ISO
+	if d.HasChange("iso") {
+		log.Printf("[DEBUG] ISO changed for %s, starting update", name)
+
+		o, n := d.GetChange("iso")
+
+		if o.(string) != "" {
+			// Detach the current ISO
+			r, err := cs.noWait.ISO.DetachIso(cs.ISO.NewDetachIsoParams(d.Id()))
+			if err == nil {
+				err = cs.waitForJob(r.JobID, timeout, nil)
+			}
+			if err != nil {
+				return fmt.Errorf(
+					"Error detaching ISO %s from instance %s: %s", o.(string), name, err)
+			}
+		}
+
+		if n.(string) != "" {
+			if err := resourceCosmicInstanceAttachISO(d, meta, timeout); err != nil {
+				return fmt.Errorf(
+					"Error attaching ISO %s to instance %s: %s", n.(string), name, err)
+			}
+		}
+
+		d.SetPartial("iso")
+	}
+
 	// Attributes that require reboot to update
 	if d.HasChange("name") || d.HasChange("service_offering") || d.HasChange("affinity_group_ids") ||
 		d.HasChange("affinity_group_names") || d.HasChange("keypair") || d.HasChange("user_data") ||
 		d.HasChange("optimise_for") {
 		// Before we can actually make these changes, the virtual machine must be stopped
-		_, err := cs.VirtualMachine.StopVirtualMachine(
+		r, err := cs.noWait.VirtualMachine.StopVirtualMachine(
 			cs.VirtualMachine.NewStopVirtualMachineParams(d.Id()))
+		if err == nil {
+			err = cs.waitForJob(r.JobID, timeout, nil)
+		}
 		if err != nil {
 			return fmt.Errorf(
 				"Error stopping instance %s before making changes: %s", name, err)
@@ -429,7 +519,10 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			p.SetAffinitygroupids(groups)
 
 			// Update the affinity groups
-			_, err = cs.AffinityGroup.UpdateVMAffinityGroup(p)
+			r, err := cs.noWait.AffinityGroup.UpdateVMAffinityGroup(p)
+			if err == nil {
+				err = cs.waitForJob(r.JobID, timeout, nil)
+			}
 			if err != nil {
 				return fmt.Errorf(
 					"Error updating the affinity groups for instance %s: %s", name, err)
@@ -452,7 +545,10 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			p.SetAffinitygroupnames(groups)
 
 			// Update the affinity groups
-			_, err = cs.AffinityGroup.UpdateVMAffinityGroup(p)
+			r, err := cs.noWait.AffinityGroup.UpdateVMAffinityGroup(p)
+			if err == nil {
+				err = cs.waitForJob(r.JobID, timeout, nil)
+			}
 			if err != nil {
 				return fmt.Errorf(
 					"Error updating the affinity groups for instance %s: %s", name, err)
@@ -467,7 +563,10 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			p := cs.SSH.NewResetSSHKeyForVirtualMachineParams(d.Id(), d.Get("keypair").(string))
 
 			// Change the ssh keypair
-			_, err = cs.SSH.ResetSSHKeyForVirtualMachine(p)
+			r, err := cs.noWait.SSH.ResetSSHKeyForVirtualMachine(p)
+			if err == nil {
+				err = cs.waitForJob(r.JobID, timeout, nil)
+			}
 			if err != nil {
 				return fmt.Errorf(
 					"Error changing the SSH keypair for instance %s: %s", name, err)
@@ -514,8 +613,11 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		// Start the virtual machine again
-		_, err = cs.VirtualMachine.StartVirtualMachine(
+		rs, err := cs.noWait.VirtualMachine.StartVirtualMachine(
 			cs.VirtualMachine.NewStartVirtualMachineParams(d.Id()))
+		if err == nil {
+			err = cs.waitForJob(rs.JobID, timeout, nil)
+		}
 		if err != nil {
 			return fmt.Errorf(
 				"Error starting instance %s after making changes", name)
@@ -528,7 +630,7 @@ func resourceCosmicInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCosmicInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VirtualMachine.NewDestroyVirtualMachineParams(d.Id())
@@ -538,11 +640,12 @@ func resourceCosmicInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[INFO] Destroying instance: %s", d.Get("name").(string))
-	if _, err := cs.VirtualMachine.DestroyVirtualMachine(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+	r, err := cs.noWait.VirtualMachine.DestroyVirtualMachine(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, asyncTimeout(d, schema.TimeoutDelete), nil)
+	}
+	if err != nil {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -552,6 +655,47 @@ func resourceCosmicInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// retrieveInstanceGroupName returns the name of the configured group, which
+// can be either the name or the ID of an instance group
+func retrieveInstanceGroupName(cs *Client, d *schema.ResourceData) (string, error) {
+	group := d.Get("group").(string)
+	if !cosmic.IsID(group) {
+		return group, nil
+	}
+
+	g, _, err := cs.VMGroup.GetInstanceGroupByID(group, withProject(cs, d))
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving instance group %s: %s", group, err)
+	}
+
+	return g.Name, nil
+}
+
+// resourceCosmicInstanceAttachISO attaches the configured ISO to the instance
+func resourceCosmicInstanceAttachISO(d *schema.ResourceData, meta interface{}, timeout int64) error {
+	cs := meta.(*Client)
+
+	// Retrieve the zone ID
+	zoneid, e := retrieveID(cs, "zone", d.Get("zone").(string))
+	if e != nil {
+		return e.Error()
+	}
+
+	// Retrieve the ISO ID
+	isoid, e := retrieveISOID(cs, zoneid, getProject(cs, d), d.Get("iso").(string))
+	if e != nil {
+		return e.Error()
+	}
+
+	// Attach the ISO
+	r, err := cs.noWait.ISO.AttachIso(cs.ISO.NewAttachIsoParams(isoid, d.Id()))
+	if err != nil {
+		return err
+	}
+
+	return cs.waitForJob(r.JobID, timeout, nil)
+}
+
 // getUserData returns the user data as a base64 encoded string
 func getUserData(userData string, httpGetOnly bool) (string, error) {
 	ud := base64.StdEncoding.EncodeToString([]byte(userData))
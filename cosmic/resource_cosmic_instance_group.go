@@ -0,0 +1,123 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCosmicInstanceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCosmicInstanceGroupCreate,
+		Read:   resourceCosmicInstanceGroupRead,
+		Update: resourceCosmicInstanceGroupUpdate,
+		Delete: resourceCosmicInstanceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCosmicInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	name := d.Get("name").(string)
+
+	// Create a new parameter struct
+	p := cs.VMGroup.NewCreateInstanceGroupParams(name)
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating instance group %s", name)
+	r, err := cs.VMGroup.CreateInstanceGroup(p)
+	if err != nil {
+		return fmt.Errorf("Error creating instance group %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Instance group %s successfully created", name)
+	d.SetId(r.Id)
+
+	return resourceCosmicInstanceGroupRead(d, meta)
+}
+
+func resourceCosmicInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Get the instance group details
+	g, _, err := cs.VMGroup.GetInstanceGroupByID(
+		d.Id(),
+		withProject(cs, d),
+	)
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			log.Printf("[DEBUG] Instance group %s does no longer exist", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", g.Name)
+
+	setValueOrID(d, "project", g.Project, g.Projectid)
+
+	return nil
+}
+
+func resourceCosmicInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+
+		// Create a new parameter struct
+		p := cs.VMGroup.NewUpdateInstanceGroupParams(d.Id())
+		p.SetName(name)
+
+		// Rename the instance group
+		_, err := cs.VMGroup.UpdateInstanceGroup(p)
+		if err != nil {
+			return fmt.Errorf("Error renaming instance group %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCosmicInstanceGroupRead(d, meta)
+}
+
+func resourceCosmicInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.VMGroup.NewDeleteInstanceGroupParams(d.Id())
+
+	// Delete the instance group
+	log.Printf("[INFO] Deleting instance group: %s", d.Get("name").(string))
+	_, err := cs.VMGroup.DeleteInstanceGroup(p)
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting instance group %s: %s", d.Get("name").(string), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCosmicInstanceGroup_basic(t *testing.T) {
+	var group cosmic.InstanceGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicInstanceGroup_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicInstanceGroupExists("cosmic_instance_group.foo", &group),
+					testAccCheckCosmicInstanceGroupName(&group, "terraform-group"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicInstanceGroup_rename(t *testing.T) {
+	var group cosmic.InstanceGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicInstanceGroup_instance("terraform-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicInstanceGroupExists("cosmic_instance_group.foo", &group),
+					resource.TestCheckResourceAttrPair(
+						"cosmic_instance.foo", "group", "cosmic_instance_group.foo", "id"),
+				),
+			},
+
+			{
+				Config: testAccCosmicInstanceGroup_instance("terraform-group-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicInstanceGroupExists("cosmic_instance_group.foo", &group),
+					testAccCheckCosmicInstanceGroupName(&group, "terraform-group-renamed"),
+					resource.TestCheckResourceAttrPair(
+						"cosmic_instance.foo", "group", "cosmic_instance_group.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCosmicInstanceGroupExists(
+	n string, group *cosmic.InstanceGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance group ID is set")
+		}
+
+		cs := testAccProvider.Meta().(*Client)
+		g, _, err := cs.VMGroup.GetInstanceGroupByID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if g.Id != rs.Primary.ID {
+			return fmt.Errorf("Instance group not found")
+		}
+
+		*group = *g
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicInstanceGroupName(
+	group *cosmic.InstanceGroup, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if group.Name != name {
+			return fmt.Errorf("Bad name: %s", group.Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicInstanceGroupDestroy(s *terraform.State) error {
+	cs := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cosmic_instance_group" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance group ID is set")
+		}
+
+		_, _, err := cs.VMGroup.GetInstanceGroupByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Instance group %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccCosmicInstanceGroup_basic = `
+resource "cosmic_instance_group" "foo" {
+  name = "terraform-group"
+}`
+
+func testAccCosmicInstanceGroup_instance(name string) string {
+	return fmt.Sprintf(`
+resource "cosmic_instance_group" "foo" {
+  name = "%s"
+}
+
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  group            = "${cosmic_instance_group.foo.id}"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}`,
+		name,
+		COSMIC_VPC_NETWORK_OFFERING,
+		COSMIC_VPC_ID,
+		COSMIC_ZONE,
+		COSMIC_SERVICE_OFFERING_1,
+		COSMIC_TEMPLATE)
+}
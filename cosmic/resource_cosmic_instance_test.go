@@ -47,6 +47,8 @@ func TestAccCosmicInstance_update(t *testing.T) {
 					testAccCheckCosmicInstanceAttributes(&instance),
 					resource.TestCheckResourceAttr(
 						"cosmic_instance.foo", "user_data", "0cf3dcdc356ec8369494cb3991985ecd5296cdd5"),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "tags.terraform-tag", "true"),
 				),
 			},
 
@@ -62,6 +64,8 @@ func TestAccCosmicInstance_update(t *testing.T) {
 						"cosmic_instance.foo", "display_name", "terraform-updated"),
 					resource.TestCheckResourceAttr(
 						"cosmic_instance.foo", "service_offering", COSMIC_SERVICE_OFFERING_2),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "tags.terraform-tag", "updated"),
 				),
 			},
 		},
@@ -143,7 +147,7 @@ func testAccCheckCosmicInstanceExists(
 			return fmt.Errorf("No instance ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(rs.Primary.ID)
 
 		if err != nil {
@@ -215,7 +219,7 @@ func testAccCheckCosmicInstanceRenamedAndResized(
 }
 
 func testAccCheckCosmicInstanceDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_instance" {
@@ -254,6 +258,10 @@ resource "cosmic_instance" "foo" {
   zone             = "${cosmic_network.foo.zone}"
   user_data        = "foobar\nfoo\nbar"
   expunge          = true
+
+  tags = {
+    terraform-tag = "true"
+  }
 }`,
 	COSMIC_VPC_NETWORK_OFFERING,
 	COSMIC_VPC_ID,
@@ -280,6 +288,10 @@ resource "cosmic_instance" "foo" {
   zone             = "${cosmic_network.foo.zone}"
   user_data        = "foobar\nfoo\nbar"
   expunge          = true
+
+  tags = {
+    terraform-tag = "updated"
+  }
 }`,
 	COSMIC_VPC_NETWORK_OFFERING,
 	COSMIC_VPC_ID,
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -36,6 +34,7 @@ func resourceCosmicIPAddress() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceCosmicIPAddressImporter,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"network_id": &schema.Schema{
@@ -53,7 +52,6 @@ func resourceCosmicIPAddress() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
@@ -63,12 +61,16 @@ func resourceCosmicIPAddress() *schema.Resource {
 			},
 
 			"acl_id": aclidSchema,
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicIPAddressCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	if err := verifyIPAddressParams(d); err != nil {
 		return err
@@ -100,6 +102,11 @@ func resourceCosmicIPAddressCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "PublicIpAddress")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	// Set the ACL if we are on a VPC and acl_id is supplied
 	if _, ok := d.GetOk("vpc_id"); ok {
 		if aclid, ok := d.GetOk("acl_id"); ok && aclid.(string) != none {
@@ -117,15 +124,15 @@ func resourceCosmicIPAddressCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCosmicIPAddressRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the IP address details
-	ip, count, err := cs.PublicIPAddress.GetPublicIpAddressByID(
+	ip, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] IP address with ID %s is no longer associated", d.Id())
 			d.SetId("")
@@ -151,13 +158,19 @@ func resourceCosmicIPAddressRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("acl_id", ip.Aclid)
 
+	tags, err := getTags(cs, d, "PublicIpAddress")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	setValueOrID(d, "project", ip.Project, ip.Projectid)
 
 	return nil
 }
 
 func resourceCosmicIPAddressUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Replace the ACL if the ID has changed
 	if d.HasChange("acl_id") {
@@ -170,21 +183,26 @@ func resourceCosmicIPAddressUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "PublicIpAddress")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceCosmicIPAddressRead(d, meta)
 }
 
 func resourceCosmicIPAddressDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.PublicIPAddress.NewDisassociateIpAddressParams(d.Id())
 
 	// Disassociate the IP address
 	if _, err := cs.PublicIPAddress.DisassociateIpAddress(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -195,10 +213,10 @@ func resourceCosmicIPAddressDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCosmicIPAddressImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	ip, _, _ := cs.PublicIPAddress.GetPublicIpAddressByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 
 	// Set the vpc_id if the IP is attached to a VPC.
@@ -41,7 +41,7 @@ func testAccCheckCosmicIPAddressExists(
 			return fmt.Errorf("No IP address ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		pip, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(rs.Primary.ID)
 
 		if err != nil {
@@ -77,7 +77,7 @@ func testAccCheckCosmicIPAddressAttributes(
 }
 
 func testAccCheckCosmicIPAddressDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_ipaddress" {
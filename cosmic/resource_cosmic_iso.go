@@ -0,0 +1,343 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCosmicISO() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCosmicISOCreate,
+		Read:   resourceCosmicISORead,
+		Update: resourceCosmicISOUpdate,
+		Delete: resourceCosmicISODelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"display_text": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"os_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"bootable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_dynamically_scalable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_extractable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"is_featured": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_ready": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceCosmicISOCreate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	name := d.Get("name").(string)
+
+	// Compute/set the display text
+	displaytext := d.Get("display_text").(string)
+	if displaytext == "" {
+		displaytext = name
+	}
+
+	// Retrieve the zone ID
+	zoneid, e := retrieveID(cs, "zone", d.Get("zone").(string))
+	if e != nil {
+		return e.Error()
+	}
+
+	// Create a new parameter struct
+	p := cs.ISO.NewRegisterIsoParams(
+		displaytext,
+		name,
+		d.Get("url").(string),
+		zoneid)
+
+	// Set optional parameters
+	if v, ok := d.GetOk("checksum"); ok {
+		p.SetChecksum(v.(string))
+	}
+
+	if v, ok := d.GetOk("os_type"); ok {
+		ostypeid, e := retrieveID(cs, "os_type", v.(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetOstypeid(ostypeid)
+	}
+
+	if v, ok := d.GetOk("bootable"); ok {
+		p.SetBootable(v.(bool))
+	}
+
+	if v, ok := d.GetOk("is_dynamically_scalable"); ok {
+		p.SetIsdynamicallyscalable(v.(bool))
+	}
+
+	if v, ok := d.GetOk("is_extractable"); ok {
+		p.SetIsextractable(v.(bool))
+	}
+
+	if v, ok := d.GetOk("is_featured"); ok {
+		p.SetIsfeatured(v.(bool))
+	}
+
+	if v, ok := d.GetOk("is_public"); ok {
+		p.SetIspublic(v.(bool))
+	}
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	// Create the new ISO
+	r, err := cs.ISO.RegisterIso(p)
+	if err != nil {
+		return fmt.Errorf("Error creating ISO %s: %s", name, err)
+	}
+
+	// The API returns a list of registered ISOs, which go-cosmic doesn't
+	// decode, so the ID may have to be retrieved using the name
+	id := r.Id
+	if id == "" {
+		id, _, err = cs.ISO.GetIsoID(name, "self", zoneid, withProject(cs, d))
+		if err != nil {
+			return fmt.Errorf("Error retrieving the ID of the new ISO %s: %s", name, err)
+		}
+	}
+
+	d.SetId(id)
+
+	err = setTags(cs, d, "ISO")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	// Wait until the ISO is ready to use, or timeout with an error...
+	currentTime := time.Now().Unix()
+	timeout := int64(d.Timeout(schema.TimeoutCreate) / time.Second)
+	for {
+		// Start with the sleep so the register action has a few seconds
+		// to process the registration correctly. Without this wait
+		time.Sleep(10 * time.Second)
+
+		err := resourceCosmicISORead(d, meta)
+		if err != nil {
+			return err
+		}
+
+		if d.Get("is_ready").(bool) {
+			return nil
+		}
+
+		if time.Now().Unix()-currentTime > timeout {
+			return fmt.Errorf("Timeout while waiting for ISO to become ready")
+		}
+	}
+}
+
+func resourceCosmicISORead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Get the ISO details
+	iso, _, err := cs.ISO.GetIsoByID(
+		d.Id(),
+		withProject(cs, d),
+	)
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			log.Printf(
+				"[DEBUG] ISO %s no longer exists", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", iso.Name)
+	d.Set("display_text", iso.Displaytext)
+	d.Set("checksum", iso.Checksum)
+	d.Set("bootable", iso.Bootable)
+	d.Set("is_dynamically_scalable", iso.Isdynamicallyscalable)
+	d.Set("is_extractable", iso.Isextractable)
+	d.Set("is_featured", iso.Isfeatured)
+	d.Set("is_public", iso.Ispublic)
+	d.Set("is_ready", iso.Isready)
+
+	tags, err := getTags(cs, d, "ISO")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
+	setValueOrID(d, "os_type", iso.Ostypename, iso.Ostypeid)
+	setValueOrID(d, "project", iso.Project, iso.Projectid)
+	setValueOrID(d, "zone", iso.Zonename, iso.Zoneid)
+
+	return nil
+}
+
+func resourceCosmicISOUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+	name := d.Get("name").(string)
+
+	// Create a new parameter struct
+	p := cs.ISO.NewUpdateIsoParams(d.Id())
+
+	if d.HasChange("name") {
+		p.SetName(name)
+	}
+
+	if d.HasChange("display_text") {
+		p.SetDisplaytext(d.Get("display_text").(string))
+	}
+
+	if d.HasChange("bootable") {
+		p.SetBootable(d.Get("bootable").(bool))
+	}
+
+	if d.HasChange("is_dynamically_scalable") {
+		p.SetIsdynamicallyscalable(d.Get("is_dynamically_scalable").(bool))
+	}
+
+	if d.HasChange("os_type") {
+		ostypeid, e := retrieveID(cs, "os_type", d.Get("os_type").(string))
+		if e != nil {
+			return e.Error()
+		}
+		p.SetOstypeid(ostypeid)
+	}
+
+	_, err := cs.ISO.UpdateIso(p)
+	if err != nil {
+		return fmt.Errorf("Error updating ISO %s: %s", name, err)
+	}
+
+	// Update the permissions if they have changed
+	if d.HasChange("is_public") {
+		p := cs.ISO.NewUpdateIsoPermissionsParams(d.Id())
+		p.SetIspublic(d.Get("is_public").(bool))
+
+		_, err := cs.ISO.UpdateIsoPermissions(p)
+		if err != nil {
+			return fmt.Errorf("Error updating the permissions of ISO %s: %s", name, err)
+		}
+	}
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "ISO")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicISORead(d, meta)
+}
+
+func resourceCosmicISODelete(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.ISO.NewDeleteIsoParams(d.Id())
+
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
+	// Delete the ISO
+	log.Printf("[INFO] Deleting ISO: %s", d.Get("name").(string))
+	r, err := cs.noWait.ISO.DeleteIso(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting ISO %s: %s", d.Get("name").(string), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,379 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCosmicISO_basic(t *testing.T) {
+	var iso cosmic.Iso
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicISODestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicISO_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					testAccCheckCosmicISOBasicAttributes(&iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_iso.foo", "display_text", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicISO_update(t *testing.T) {
+	var iso cosmic.Iso
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicISODestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicISO_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					testAccCheckCosmicISOBasicAttributes(&iso),
+				),
+			},
+
+			{
+				Config: testAccCosmicISO_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_iso.foo", "display_text", "terraform-updated"),
+					resource.TestCheckResourceAttr(
+						"cosmic_iso.foo", "is_dynamically_scalable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicISO_attach(t *testing.T) {
+	var iso cosmic.Iso
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicISODestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicISO_attach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "iso", "terraform-test"),
+				),
+			},
+
+			{
+				Config: testAccCosmicISO_detach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "iso", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicISO_attachNonBootable(t *testing.T) {
+	var iso cosmic.Iso
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicISODestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicISO_attachNonBootable,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_iso.foo", "bootable", "false"),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "iso", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicISO_attachNonBootableAsUser(t *testing.T) {
+	var iso cosmic.Iso
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckUser(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicISODestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicISO_attachNonBootableAsUser,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicISOExists("cosmic_iso.foo", &iso),
+					resource.TestCheckResourceAttr(
+						"cosmic_iso.foo", "bootable", "false"),
+					resource.TestCheckResourceAttr(
+						"cosmic_instance.foo", "iso", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCosmicISOExists(
+	n string, iso *cosmic.Iso) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ISO ID is set")
+		}
+
+		cs := testAccProvider.Meta().(*Client)
+		i, _, err := cs.ISO.GetIsoByID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if i.Id != rs.Primary.ID {
+			return fmt.Errorf("ISO not found")
+		}
+
+		*iso = *i
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicISOBasicAttributes(
+	iso *cosmic.Iso) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if iso.Name != "terraform-test" {
+			return fmt.Errorf("Bad name: %s", iso.Name)
+		}
+
+		if !iso.Bootable {
+			return fmt.Errorf("Bad bootable: %t", iso.Bootable)
+		}
+
+		if iso.Ostypename != "Other PV (64-bit)" {
+			return fmt.Errorf("Bad os type: %s", iso.Ostypename)
+		}
+
+		if iso.Zonename != COSMIC_ZONE {
+			return fmt.Errorf("Bad zone: %s", iso.Zonename)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicISODestroy(s *terraform.State) error {
+	cs := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cosmic_iso" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ISO ID is set")
+		}
+
+		_, _, err := cs.ISO.GetIsoByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("ISO %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccCosmicISO_basic = fmt.Sprintf(`
+resource "cosmic_iso" "foo" {
+  name     = "terraform-test"
+  bootable = true
+  os_type  = "Other PV (64-bit)"
+  url      = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone     = "%s"
+}`, COSMIC_ZONE)
+
+var testAccCosmicISO_update = fmt.Sprintf(`
+resource "cosmic_iso" "foo" {
+  name                    = "terraform-test"
+  display_text            = "terraform-updated"
+  bootable                = true
+  os_type                 = "Other PV (64-bit)"
+  url                     = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone                    = "%s"
+  is_dynamically_scalable = true
+}`, COSMIC_ZONE)
+
+var testAccCosmicISO_attach = fmt.Sprintf(`
+resource "cosmic_iso" "foo" {
+  name     = "terraform-test"
+  bootable = true
+  os_type  = "Other PV (64-bit)"
+  url      = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone     = "%s"
+}
+
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  iso              = "${cosmic_iso.foo.name}"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}`,
+	COSMIC_ZONE,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
+
+var testAccCosmicISO_detach = fmt.Sprintf(`
+resource "cosmic_iso" "foo" {
+  name     = "terraform-test"
+  bootable = true
+  os_type  = "Other PV (64-bit)"
+  url      = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone     = "%s"
+}
+
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}`,
+	COSMIC_ZONE,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
+
+var testAccCosmicISO_attachNonBootable = fmt.Sprintf(`
+resource "cosmic_iso" "foo" {
+  name     = "terraform-test"
+  bootable = false
+  url      = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone     = "%s"
+}
+
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  iso              = "${cosmic_iso.foo.name}"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}`,
+	COSMIC_ZONE,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
+
+var testAccCosmicISO_attachNonBootableAsUser = fmt.Sprintf(`
+provider "cosmic" {
+  api_key    = "%s"
+  secret_key = "%s"
+}
+
+resource "cosmic_iso" "foo" {
+  name     = "terraform-test"
+  bootable = false
+  url      = "http://mirror.centos.org/centos/7/os/x86_64/images/boot.iso"
+  zone     = "%s"
+}
+
+resource "cosmic_vpc" "foo" {
+  name         = "terraform-vpc"
+  cidr         = "10.0.10.0/22"
+  vpc_offering = "%s"
+  zone         = "%s"
+}
+
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "${cosmic_vpc.foo.id}"
+  zone             = "${cosmic_vpc.foo.zone}"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  iso              = "${cosmic_iso.foo.name}"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}`,
+	COSMIC_USER_API_KEY,
+	COSMIC_USER_SECRET_KEY,
+	COSMIC_ZONE,
+	COSMIC_VPC_OFFERING,
+	COSMIC_ZONE,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -18,6 +16,7 @@ func resourceCosmicLoadBalancerRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -83,15 +82,18 @@ func resourceCosmicLoadBalancerRule() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicLoadBalancerRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	d.Partial(true)
 
@@ -143,6 +145,13 @@ func resourceCosmicLoadBalancerRuleCreate(d *schema.ResourceData, meta interface
 	d.SetPartial("public_port")
 	d.SetPartial("protocol")
 
+	err = setTags(cs, d, "LoadBalancer")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
 	// Create a new parameter struct
 	ap := cs.LoadBalancer.NewAssignToLoadBalancerRuleParams(r.Id)
 
@@ -165,15 +174,15 @@ func resourceCosmicLoadBalancerRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCosmicLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the load balancer details
-	lb, count, err := cs.LoadBalancer.GetLoadBalancerRuleByID(
+	lb, _, err := cs.LoadBalancer.GetLoadBalancerRuleByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] Load balancer rule %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
 			return nil
@@ -192,13 +201,19 @@ func resourceCosmicLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}
 		d.Set("network_id", lb.Networkid)
 	}
 
+	tags, err := getTags(cs, d, "LoadBalancer")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	setValueOrID(d, "project", lb.Project, lb.Projectid)
 
 	return nil
 }
 
 func resourceCosmicLoadBalancerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("algorithm") {
 		name := d.Get("name").(string)
@@ -238,21 +253,27 @@ func resourceCosmicLoadBalancerRuleUpdate(d *schema.ResourceData, meta interface
 				"Error updating load balancer rule %s", name)
 		}
 	}
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "LoadBalancer")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceCosmicLoadBalancerRuleRead(d, meta)
 }
 
 func resourceCosmicLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.LoadBalancer.NewDeleteLoadBalancerRuleParams(d.Id())
 
 	log.Printf("[INFO] Deleting load balancer rule: %s", d.Get("name").(string))
 	if _, err := cs.LoadBalancer.DeleteLoadBalancerRule(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if !strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if !isNotFound(err, d.Id()) {
 			return err
 		}
 	}
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -94,7 +93,7 @@ func testAccCheckCosmicLoadBalancerRuleExist(n string, id *string) resource.Test
 			*id = rs.Primary.ID
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		_, count, err := cs.LoadBalancer.GetLoadBalancerRuleByID(rs.Primary.ID)
 
 		if err != nil {
@@ -110,7 +109,7 @@ func testAccCheckCosmicLoadBalancerRuleExist(n string, id *string) resource.Test
 }
 
 func testAccCheckCosmicLoadBalancerRuleDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_loadbalancer_rule" {
@@ -5,9 +5,7 @@ import (
 	"log"
 	"net"
 	"strconv"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -40,6 +38,11 @@ func resourceCosmicNetwork() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -113,23 +116,24 @@ func resourceCosmicNetwork() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
 			"zone": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
 			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicNetworkCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 
@@ -233,15 +237,15 @@ func resourceCosmicNetworkCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmicNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the virtual machine details
-	n, count, err := cs.Network.GetNetworkByID(
+	n, _, err := cs.Network.GetNetworkByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] Network %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
@@ -264,12 +268,11 @@ func resourceCosmicNetworkRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("acl_id", n.Aclid)
 
-	// Read the tags and store them in a map
-	tags := make(map[string]interface{})
-	for item := range n.Tags {
-		tags[n.Tags[item].Key] = n.Tags[item].Value
+	tags, err := getTags(cs, d, "network")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
 	}
-	d.Set("tags", tags)
+	setTagsState(cs, d, tags)
 
 	setValueOrID(d, "network_offering", n.Networkofferingname, n.Networkofferingid)
 	setValueOrID(d, "project", n.Project, n.Projectid)
@@ -279,7 +282,7 @@ func resourceCosmicNetworkRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	name := d.Get("name").(string)
 
 	// Create a new parameter struct
@@ -323,8 +326,13 @@ func resourceCosmicNetworkUpdate(d *schema.ResourceData, meta interface{}) error
 		p.SetNetworkofferingid(networkofferingid)
 	}
 
+	timeout := asyncTimeout(d, schema.TimeoutUpdate)
+
 	// Update the network
-	_, err := cs.Network.UpdateNetwork(p)
+	r, err := cs.noWait.Network.UpdateNetwork(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
 		return fmt.Errorf(
 			"Error updating network %s: %s", name, err)
@@ -335,14 +343,17 @@ func resourceCosmicNetworkUpdate(d *schema.ResourceData, meta interface{}) error
 		p := cs.NetworkACL.NewReplaceNetworkACLListParams(d.Get("acl_id").(string))
 		p.SetNetworkid(d.Id())
 
-		_, err := cs.NetworkACL.ReplaceNetworkACLList(p)
+		r, err := cs.noWait.NetworkACL.ReplaceNetworkACLList(p)
+		if err == nil {
+			err = cs.waitForJob(r.JobID, timeout, nil)
+		}
 		if err != nil {
 			return fmt.Errorf("Error replacing ACL: %s", err)
 		}
 	}
 
 	// Update tags if they have changed
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err = setTags(cs, d, "network")
 		if err != nil {
 			return fmt.Errorf("Error updating tags: %s", err)
@@ -353,18 +364,20 @@ func resourceCosmicNetworkUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmicNetworkDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.Network.NewDeleteNetworkParams(d.Id())
 
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
 	// Delete the network
-	_, err := cs.Network.DeleteNetwork(p)
+	r, err := cs.noWait.Network.DeleteNetwork(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -13,10 +11,12 @@ func resourceCosmicNetworkACL() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCosmicNetworkACLCreate,
 		Read:   resourceCosmicNetworkACLRead,
+		Update: resourceCosmicNetworkACLUpdate,
 		Delete: resourceCosmicNetworkACLDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -43,12 +43,16 @@ func resourceCosmicNetworkACL() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 
@@ -70,19 +74,24 @@ func resourceCosmicNetworkACLCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "NetworkACLList")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicNetworkACLRead(d, meta)
 }
 
 func resourceCosmicNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the network ACL list details
-	f, count, err := cs.NetworkACL.GetNetworkACLListByID(
+	f, _, err := cs.NetworkACL.GetNetworkACLListByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] Network ACL list %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
@@ -96,24 +105,41 @@ func resourceCosmicNetworkACLRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("description", f.Description)
 	d.Set("vpc_id", f.Vpcid)
 
+	tags, err := getTags(cs, d, "NetworkACLList")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
+func resourceCosmicNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "NetworkACLList")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicNetworkACLRead(d, meta)
+}
+
 func resourceCosmicNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.NetworkACL.NewDeleteNetworkACLListParams(d.Id())
 
 	// Delete the network ACL list
-	_, err := Retry(3, func() (interface{}, error) {
+	_, err := Retry(cs, 3, func() (interface{}, error) {
 		return cs.NetworkACL.DeleteNetworkACLList(p)
 	})
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -171,7 +171,7 @@ func createNetworkACLRules(d *schema.ResourceData, meta interface{}, rules *sche
 }
 
 func createNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[string]interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	uuids := rule["uuids"].(map[string]interface{})
 
 	// Make sure all required parameters are there
@@ -199,7 +199,7 @@ func createNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[str
 		p.SetIcmptype(rule["icmp_type"].(int))
 		p.SetIcmpcode(rule["icmp_code"].(int))
 
-		r, err := Retry(4, retryableACLCreationFunc(cs, p))
+		r, err := Retry(cs, 4, retryableACLCreationFunc(cs, p))
 		if err != nil {
 			return err
 		}
@@ -210,7 +210,7 @@ func createNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[str
 
 	// If the protocol is ALL set the needed parameters
 	if rule["protocol"].(string) == "all" {
-		r, err := Retry(4, retryableACLCreationFunc(cs, p))
+		r, err := Retry(cs, 4, retryableACLCreationFunc(cs, p))
 		if err != nil {
 			return err
 		}
@@ -251,7 +251,7 @@ func createNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[str
 				p.SetStartport(startPort)
 				p.SetEndport(endPort)
 
-				r, err := Retry(4, retryableACLCreationFunc(cs, p))
+				r, err := Retry(cs, 4, retryableACLCreationFunc(cs, p))
 				if err != nil {
 					return err
 				}
@@ -269,15 +269,15 @@ func createNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[str
 }
 
 func resourceCosmicNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// First check if the ACL itself still exists
-	_, count, err := cs.NetworkACL.GetNetworkACLListByID(
+	_, _, err := cs.NetworkACL.GetNetworkACLListByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] Network ACL list %s does no longer exist", d.Id())
 			d.SetId("")
@@ -555,7 +555,7 @@ func deleteNetworkACLRules(d *schema.ResourceData, meta interface{}, rules *sche
 }
 
 func deleteNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[string]interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	uuids := rule["uuids"].(map[string]interface{})
 
 	for k, id := range uuids {
@@ -570,10 +570,7 @@ func deleteNetworkACLRule(d *schema.ResourceData, meta interface{}, rule map[str
 		// Delete the rule
 		if _, err := cs.NetworkACL.DeleteNetworkACL(p); err != nil {
 
-			// This is a very poor way to be told the ID does no longer exist :(
-			if strings.Contains(err.Error(), fmt.Sprintf(
-				"Invalid parameter id value=%s due to incorrect long value format, "+
-					"or entity does not exist", id.(string))) {
+			if isNotFound(err, id.(string)) {
 				delete(uuids, k)
 				rule["uuids"] = uuids
 				continue
@@ -653,7 +650,7 @@ func verifyNetworkACLRuleParams(d *schema.ResourceData, rule map[string]interfac
 }
 
 func retryableACLCreationFunc(
-	cs *cosmic.CosmicClient,
+	cs *Client,
 	p *cosmic.CreateNetworkACLParams) func() (interface{}, error) {
 	return func() (interface{}, error) {
 		r, err := cs.NetworkACL.CreateNetworkACL(p)
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -165,7 +164,7 @@ func testAccCheckCosmicNetworkACLRulesExist(n string) resource.TestCheckFunc {
 				continue
 			}
 
-			cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+			cs := testAccProvider.Meta().(*Client)
 			_, count, err := cs.NetworkACL.GetNetworkACLByID(id)
 
 			if err != nil {
@@ -182,7 +181,7 @@ func testAccCheckCosmicNetworkACLRulesExist(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckCosmicNetworkACLRuleDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_network_acl_rule" {
@@ -40,7 +40,7 @@ func testAccCheckCosmicNetworkACLExists(
 			return fmt.Errorf("No network ACL ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		acllist, _, err := cs.NetworkACL.GetNetworkACLListByID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -73,7 +73,7 @@ func testAccCheckCosmicNetworkACLBasicAttributes(
 }
 
 func testAccCheckCosmicNetworkACLDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_network_acl" {
@@ -71,7 +71,7 @@ func testAccCheckCosmicNetworkExists(
 			return fmt.Errorf("No network ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		ntwrk, _, err := cs.Network.GetNetworkByID(rs.Primary.ID)
 
 		if err != nil {
@@ -122,7 +122,7 @@ func testAccCheckNetworkTags(n *cosmic.Network, key string, value string) resour
 }
 
 func testAccCheckCosmicNetworkDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_network" {
@@ -3,7 +3,6 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -42,7 +41,7 @@ func resourceCosmicNIC() *schema.Resource {
 }
 
 func resourceCosmicNICCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VirtualMachine.NewAddNicToVirtualMachineParams(
@@ -56,7 +55,7 @@ func resourceCosmicNICCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Create and attach the new NIC
-	r, err := Retry(10, retryableAddNicFunc(cs, p))
+	r, err := Retry(cs, 10, retryableAddNicFunc(cs, p))
 	if err != nil {
 		return fmt.Errorf("Error creating the new NIC: %s", err)
 	}
@@ -78,12 +77,12 @@ func resourceCosmicNICCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicNICRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the virtual machine details
-	vm, count, err := cs.VirtualMachine.GetVirtualMachineByID(d.Get("virtual_machine_id").(string))
+	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(d.Get("virtual_machine_id").(string))
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Get("virtual_machine_id").(string)) {
 			log.Printf("[DEBUG] Instance %s does no longer exist", d.Get("virtual_machine_id").(string))
 			d.SetId("")
 			return nil
@@ -113,7 +112,7 @@ func resourceCosmicNICRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicNICDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VirtualMachine.NewRemoveNicFromVirtualMachineParams(
@@ -124,10 +123,7 @@ func resourceCosmicNICDelete(d *schema.ResourceData, meta interface{}) error {
 	// Remove the NIC
 	_, err := cs.VirtualMachine.RemoveNicFromVirtualMachine(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -137,7 +133,7 @@ func resourceCosmicNICDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func retryableAddNicFunc(cs *cosmic.CosmicClient, p *cosmic.AddNicToVirtualMachineParams) func() (interface{}, error) {
+func retryableAddNicFunc(cs *Client, p *cosmic.AddNicToVirtualMachineParams) func() (interface{}, error) {
 	return func() (interface{}, error) {
 		r, err := cs.VirtualMachine.AddNicToVirtualMachine(p)
 		if err != nil {
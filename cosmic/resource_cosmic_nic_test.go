@@ -82,7 +82,7 @@ func testAccCheckCosmicNICExists(
 			return fmt.Errorf("No NIC ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(rsv.Primary.ID)
 
 		if err != nil {
@@ -129,7 +129,7 @@ func testAccCheckCosmicNICIPAddress(
 }
 
 func testAccCheckCosmicNICDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	// Deleting the instance automatically deletes any additional NICs
 	for _, rs := range s.RootModule().Resources {
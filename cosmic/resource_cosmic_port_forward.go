@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +21,7 @@ func resourceCosmicPortForward() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"ip_address_id": &schema.Schema{
@@ -79,6 +79,10 @@ func resourceCosmicPortForward() *schema.Resource {
 					},
 				},
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -142,7 +146,7 @@ func createPortForwards(d *schema.ResourceData, meta interface{}, forwards *sche
 }
 
 func createPortForward(d *schema.ResourceData, meta interface{}, forward map[string]interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Make sure all required parameters are there
 	if err := verifyPortForwardParams(d, forward); err != nil {
@@ -151,7 +155,7 @@ func createPortForward(d *schema.ResourceData, meta interface{}, forward map[str
 
 	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(
 		forward["virtual_machine_id"].(string),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
 		return err
@@ -194,19 +198,26 @@ func createPortForward(d *schema.ResourceData, meta interface{}, forward map[str
 
 	forward["uuid"] = r.Id
 
+	// Tag the new forward with the configured tags
+	tags := d.Get("tags_all").(map[string]interface{})
+	err = updateTags(cs, []string{r.Id}, "PortForwardingRule", map[string]interface{}{}, tags)
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceCosmicPortForwardRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// First check if the IP address is still associated
-	_, count, err := cs.PublicIPAddress.GetPublicIpAddressByID(
+	_, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] IP address with ID %s is no longer associated", d.Id())
 			d.SetId("")
@@ -239,6 +250,9 @@ func resourceCosmicPortForwardRead(d *schema.ResourceData, meta interface{}) err
 	// Create an empty schema.Set to hold all forwards
 	forwards := resourceCosmicPortForward().Schema["forward"].ZeroValue().(*schema.Set)
 
+	// All forwards share the same tags, so we read them from the first one
+	var tagsFrom string
+
 	// Read all forwards that are configured
 	if rs := d.Get("forward").(*schema.Set); rs.Len() > 0 {
 		for _, forward := range rs.List() {
@@ -259,6 +273,10 @@ func resourceCosmicPortForwardRead(d *schema.ResourceData, meta interface{}) err
 			// Delete the known rule so only unknown rules remain in the ruleMap
 			delete(forwardMap, id.(string))
 
+			if tagsFrom == "" {
+				tagsFrom = f.Id
+			}
+
 			privPort, err := strconv.Atoi(f.Privateport)
 			if err != nil {
 				return err
@@ -304,6 +322,14 @@ func resourceCosmicPortForwardRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if tagsFrom != "" {
+		tags, err := getResourceTags(cs, d, tagsFrom, "PortForwardingRule")
+		if err != nil {
+			return fmt.Errorf("Error reading tags: %s", err)
+		}
+		setTagsState(cs, d, tags)
+	}
+
 	if forwards.Len() > 0 {
 		d.Set("forward", forwards)
 	} else if !managed {
@@ -314,6 +340,29 @@ func resourceCosmicPortForwardRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCosmicPortForwardUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update the tags of all forwards we keep, any new forwards will
+	// be created with the new tags
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("forward")
+
+		var ids []string
+		for _, forward := range o.(*schema.Set).Intersection(n.(*schema.Set)).List() {
+			if uuid := forward.(map[string]interface{})["uuid"].(string); uuid != "" {
+				ids = append(ids, uuid)
+			}
+		}
+
+		if len(ids) > 0 {
+			ot, nt := d.GetChange("tags_all")
+			err := updateTags(cs, ids, "PortForwardingRule", ot.(map[string]interface{}), nt.(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("Error updating tags: %s", err)
+			}
+		}
+	}
+
 	// Check if the forward set as a whole has changed
 	if d.HasChange("forward") {
 		o, n := d.GetChange("forward")
@@ -411,17 +460,14 @@ func deletePortForwards(d *schema.ResourceData, meta interface{}, forwards *sche
 }
 
 func deletePortForward(d *schema.ResourceData, meta interface{}, forward map[string]interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create the parameter struct
 	p := cs.Firewall.NewDeletePortForwardingRuleParams(forward["uuid"].(string))
 
 	// Delete the forward
 	if _, err := cs.Firewall.DeletePortForwardingRule(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if !strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", forward["uuid"].(string))) {
+		if !isNotFound(err, forward["uuid"].(string)) {
 			return err
 		}
 	}
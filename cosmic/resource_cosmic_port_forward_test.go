@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -71,7 +70,7 @@ func testAccCheckCosmicPortForwardsExist(n string) resource.TestCheckFunc {
 				continue
 			}
 
-			cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+			cs := testAccProvider.Meta().(*Client)
 			_, count, err := cs.Firewall.GetPortForwardingRuleByID(id)
 
 			if err != nil {
@@ -88,7 +87,7 @@ func testAccCheckCosmicPortForwardsExist(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckCosmicPortForwardDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_port_forward" {
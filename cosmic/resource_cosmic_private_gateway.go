@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -13,10 +11,16 @@ func resourceCosmicPrivateGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCosmicPrivateGatewayCreate,
 		Read:   resourceCosmicPrivateGatewayRead,
+		Update: resourceCosmicPrivateGatewayUpdate,
 		Delete: resourceCosmicPrivateGatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ip_address": &schema.Schema{
@@ -42,12 +46,16 @@ func resourceCosmicPrivateGateway() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicPrivateGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	ipaddress := d.Get("ip_address").(string)
 
@@ -61,24 +69,34 @@ func resourceCosmicPrivateGatewayCreate(d *schema.ResourceData, meta interface{}
 	// Set the acl ID
 	p.SetAclid(d.Get("acl_id").(string))
 
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
 	// Create the new private gateway
-	r, err := cs.VPC.CreatePrivateGateway(p)
+	r, err := cs.noWait.VPC.CreatePrivateGateway(p)
+	if err == nil {
+		err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating private gateway for %s: %s", ipaddress, err)
 	}
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "PrivateGateway")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicPrivateGatewayRead(d, meta)
 }
 
 func resourceCosmicPrivateGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the private gateway details
-	gw, count, err := cs.VPC.GetPrivateGatewayByID(d.Id())
+	gw, _, err := cs.VPC.GetPrivateGatewayByID(d.Id())
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] Private gateway %s does no longer exist", d.Id())
 			d.SetId("")
 			return nil
@@ -92,22 +110,44 @@ func resourceCosmicPrivateGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("acl_id", gw.Aclid)
 	d.Set("vpc_id", gw.Vpcid)
 
+	tags, err := getTags(cs, d, "PrivateGateway")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
+func resourceCosmicPrivateGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "PrivateGateway")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicPrivateGatewayRead(d, meta)
+}
+
 func resourceCosmicPrivateGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPC.NewDeletePrivateGatewayParams(d.Id())
 
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
 	// Delete the private gateway
-	_, err := cs.VPC.DeletePrivateGateway(p)
+	r, err := cs.noWait.VPC.DeletePrivateGateway(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -40,7 +40,7 @@ func testAccCheckCosmicPrivateGatewayExists(n string, gateway *cosmic.PrivateGat
 			return fmt.Errorf("No Private Gateway ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		pgw, _, err := cs.VPC.GetPrivateGatewayByID(rs.Primary.ID)
 
 		if err != nil {
@@ -69,7 +69,7 @@ func testAccCheckCosmicPrivateGatewayAttributes(gateway *cosmic.PrivateGateway)
 }
 
 func testAccCheckCosmicPrivateGatewayDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_private_gateway" {
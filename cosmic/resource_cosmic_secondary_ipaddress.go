@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -43,16 +41,16 @@ func resourceCosmicSecondaryIPAddress() *schema.Resource {
 }
 
 func resourceCosmicSecondaryIPAddressCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	nicid, ok := d.GetOk("nic_id")
 	if !ok {
 		virtualmachineid := d.Get("virtual_machine_id").(string)
 
 		// Get the virtual machine details
-		vm, count, err := cs.VirtualMachine.GetVirtualMachineByID(virtualmachineid)
+		vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(virtualmachineid)
 		if err != nil {
-			if count == 0 {
+			if isNotFound(err, virtualmachineid) {
 				log.Printf("[DEBUG] Virtual Machine %s does no longer exist", virtualmachineid)
 				d.SetId("")
 				return nil
@@ -82,14 +80,14 @@ func resourceCosmicSecondaryIPAddressCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceCosmicSecondaryIPAddressRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	virtualmachineid := d.Get("virtual_machine_id").(string)
 
 	// Get the virtual machine details
-	vm, count, err := cs.VirtualMachine.GetVirtualMachineByID(virtualmachineid)
+	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(virtualmachineid)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, virtualmachineid) {
 			log.Printf("[DEBUG] Virtual Machine %s does no longer exist", virtualmachineid)
 			d.SetId("")
 			return nil
@@ -136,17 +134,14 @@ func resourceCosmicSecondaryIPAddressRead(d *schema.ResourceData, meta interface
 }
 
 func resourceCosmicSecondaryIPAddressDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.Nic.NewRemoveIpFromNicParams(d.Id())
 
 	log.Printf("[INFO] Removing secondary IP address: %s", d.Get("ip_address").(string))
 	if _, err := cs.Nic.RemoveIpFromNic(p); err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -62,7 +62,7 @@ func testAccCheckCosmicSecondaryIPAddressExists(
 			return fmt.Errorf("No IP address ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 
 		virtualmachine, ok := rs.Primary.Attributes["virtual_machine_id"]
 		if !ok {
@@ -132,7 +132,7 @@ func testAccCheckCosmicSecondaryIPAddressAttributes(
 }
 
 func testAccCheckCosmicSecondaryIPAddressDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_secondary_ipaddress" {
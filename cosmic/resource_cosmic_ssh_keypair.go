@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -51,7 +49,7 @@ func resourceCosmicSSHKeyPair() *schema.Resource {
 }
 
 func resourceCosmicSSHKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 	publicKey := d.Get("public_key").(string)
@@ -92,7 +90,7 @@ func resourceCosmicSSHKeyPairCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCosmicSSHKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	log.Printf("[DEBUG] looking for key pair with name %s", d.Id())
 
@@ -122,7 +120,7 @@ func resourceCosmicSSHKeyPairRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCosmicSSHKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.SSH.NewDeleteSSHKeyPairParams(d.Id())
@@ -135,9 +133,7 @@ func resourceCosmicSSHKeyPairDelete(d *schema.ResourceData, meta interface{}) er
 	// Remove the SSH Keypair
 	_, err := cs.SSH.DeleteSSHKeyPair(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"A key pair with name '%s' does not exist for account", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -62,7 +62,7 @@ func testAccCheckCosmicSSHKeyPairExists(n string, sshkey *cosmic.SSHKeyPair) res
 			return fmt.Errorf("No key pair ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		p := cs.SSH.NewListSSHKeyPairsParams()
 		p.SetName(rs.Primary.ID)
 
@@ -126,7 +126,7 @@ func testAccCheckCosmicSSHKeyPairCreateAttributes(name string) resource.TestChec
 }
 
 func testAccCheckCosmicSSHKeyPairDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_ssh_keypair" {
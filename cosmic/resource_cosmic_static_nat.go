@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -42,7 +40,6 @@ func resourceCosmicStaticNAT() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 		},
@@ -50,13 +47,13 @@ func resourceCosmicStaticNAT() *schema.Resource {
 }
 
 func resourceCosmicStaticNATCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	ipaddressid := d.Get("ip_address_id").(string)
 
 	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(
 		d.Get("virtual_machine_id").(string),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
 		return err
@@ -99,15 +96,15 @@ func resourceCosmicStaticNATCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCosmicStaticNATExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the IP address details
-	ip, count, err := cs.PublicIPAddress.GetPublicIpAddressByID(
+	ip, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] IP address with ID %s no longer exists", d.Id())
 			return false, nil
 		}
@@ -119,15 +116,15 @@ func resourceCosmicStaticNATExists(d *schema.ResourceData, meta interface{}) (bo
 }
 
 func resourceCosmicStaticNATRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the IP address details
-	ip, count, err := cs.PublicIPAddress.GetPublicIpAddressByID(
+	ip, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] IP address with ID %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -151,7 +148,7 @@ func resourceCosmicStaticNATRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmicStaticNATDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.NAT.NewDisableStaticNatParams(d.Id())
@@ -159,10 +156,7 @@ func resourceCosmicStaticNATDelete(d *schema.ResourceData, meta interface{}) err
 	// Disable static NAT
 	_, err := cs.NAT.DisableStaticNat(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
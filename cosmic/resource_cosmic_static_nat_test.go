@@ -41,7 +41,7 @@ func testAccCheckCosmicStaticNATExists(
 			return fmt.Errorf("No static NAT ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		ip, _, err := cs.PublicIPAddress.GetPublicIpAddressByID(rs.Primary.ID)
 
 		if err != nil {
@@ -75,7 +75,7 @@ func testAccCheckCosmicStaticNATAttributes(
 }
 
 func testAccCheckCosmicStaticNATDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_static_nat" {
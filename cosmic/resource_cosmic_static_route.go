@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -13,10 +11,12 @@ func resourceCosmicStaticRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCosmicStaticRouteCreate,
 		Read:   resourceCosmicStaticRouteRead,
+		Update: resourceCosmicStaticRouteUpdate,
 		Delete: resourceCosmicStaticRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cidr": &schema.Schema{
@@ -36,12 +36,16 @@ func resourceCosmicStaticRoute() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicStaticRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPC.NewCreateStaticRouteParams(
@@ -58,16 +62,21 @@ func resourceCosmicStaticRouteCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "StaticRoute")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicStaticRouteRead(d, meta)
 }
 
 func resourceCosmicStaticRouteRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the virtual machine details
-	route, count, err := cs.VPC.GetStaticRouteByID(d.Id())
+	route, _, err := cs.VPC.GetStaticRouteByID(d.Id())
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] Static route %s does no longer exist", d.Id())
 			d.SetId("")
 			return nil
@@ -80,11 +89,31 @@ func resourceCosmicStaticRouteRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("nexthop", route.Nexthop)
 	d.Set("vpc_id", route.Vpcid)
 
+	tags, err := getTags(cs, d, "StaticRoute")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
+func resourceCosmicStaticRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "StaticRoute")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicStaticRouteRead(d, meta)
+}
+
 func resourceCosmicStaticRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPC.NewDeleteStaticRouteParams(d.Id())
@@ -92,10 +121,7 @@ func resourceCosmicStaticRouteDelete(d *schema.ResourceData, meta interface{}) e
 	// Delete the private gateway
 	_, err := cs.VPC.DeleteStaticRoute(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -41,7 +41,7 @@ func testAccCheckCosmicStaticRouteExists(
 			return fmt.Errorf("No Static Route ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		r, _, err := cs.VPC.GetStaticRouteByID(rs.Primary.ID)
 
 		if err != nil {
@@ -71,7 +71,7 @@ func testAccCheckCosmicStaticRouteAttributes(
 }
 
 func testAccCheckCosmicStaticRouteDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_static_route" {
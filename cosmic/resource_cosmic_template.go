@@ -3,10 +3,8 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -19,6 +17,11 @@ func resourceCosmicTemplate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -57,13 +60,12 @@ func resourceCosmicTemplate() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
 			"zone": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
@@ -105,16 +107,21 @@ func resourceCosmicTemplate() *schema.Resource {
 			},
 
 			"is_ready_timeout": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  300,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Use the create timeout instead",
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	if err := verifyTemplateParams(d); err != nil {
 		return err
@@ -184,9 +191,17 @@ func resourceCosmicTemplateCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(r.RegisterTemplate[0].Id)
 
+	err = setTags(cs, d, "Template")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	// Wait until the template is ready to use, or timeout with an error...
 	currentTime := time.Now().Unix()
-	timeout := int64(d.Get("is_ready_timeout").(int))
+	timeout := int64(d.Timeout(schema.TimeoutCreate) / time.Second)
+	if v, ok := d.GetOk("is_ready_timeout"); ok {
+		timeout = int64(v.(int))
+	}
 	for {
 		// Start with the sleep so the register action has a few seconds
 		// to process the registration correctly. Without this wait
@@ -208,16 +223,16 @@ func resourceCosmicTemplateCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCosmicTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the template details
-	t, count, err := cs.Template.GetTemplateByID(
+	t, _, err := cs.Template.GetTemplateByID(
 		d.Id(),
 		"executable",
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] Template %s no longer exists", d.Get("name").(string))
 			d.SetId("")
@@ -238,6 +253,12 @@ func resourceCosmicTemplateRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("password_enabled", t.Passwordenabled)
 	d.Set("is_ready", t.Isready)
 
+	tags, err := getTags(cs, d, "Template")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	setValueOrID(d, "os_type", t.Ostypename, t.Ostypeid)
 	setValueOrID(d, "project", t.Project, t.Projectid)
 	setValueOrID(d, "zone", t.Zonename, t.Zoneid)
@@ -246,7 +267,7 @@ func resourceCosmicTemplateRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmicTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 	name := d.Get("name").(string)
 
 	// Create a new parameter struct
@@ -285,23 +306,33 @@ func resourceCosmicTemplateUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error updating template %s: %s", name, err)
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "Template")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceCosmicTemplateRead(d, meta)
 }
 
 func resourceCosmicTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.Template.NewDeleteTemplateParams(d.Id())
 
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
 	// Delete the template
 	log.Printf("[INFO] Deleting template: %s", d.Get("name").(string))
-	_, err := cs.Template.DeleteTemplate(p)
+	r, err := cs.noWait.Template.DeleteTemplate(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -0,0 +1,270 @@
+package cosmic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCosmicVMSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCosmicVMSnapshotCreate,
+		Read:   resourceCosmicVMSnapshotRead,
+		Update: resourceCosmicVMSnapshotUpdate,
+		Delete: resourceCosmicVMSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"snapshot_memory": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"revert_on_change": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"current": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceCosmicVMSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+	d.Partial(true)
+
+	virtualmachineid := d.Get("virtual_machine_id").(string)
+
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
+	var r *cosmic.CreateVMSnapshotResponse
+	var err error
+	if d.Get("snapshot_memory").(bool) {
+		r, err = createVMSnapshotWithMemory(cs, d)
+	} else {
+		// Create a new parameter struct
+		p := cs.Snapshot.NewCreateVMSnapshotParams(virtualmachineid)
+
+		if name, ok := d.GetOk("name"); ok {
+			p.SetName(name.(string))
+		}
+
+		if description, ok := d.GetOk("description"); ok {
+			p.SetDescription(description.(string))
+		}
+
+		// Create the new VM snapshot
+		r, err = cs.noWait.Snapshot.CreateVMSnapshot(p)
+	}
+	if err == nil {
+		err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating a snapshot of virtual machine %s: %s", virtualmachineid, err)
+	}
+
+	d.SetId(r.Id)
+	d.SetPartial("virtual_machine_id")
+	d.SetPartial("name")
+	d.SetPartial("description")
+	d.SetPartial("snapshot_memory")
+	d.SetPartial("revert_on_change")
+	d.SetPartial("project")
+
+	err = setTags(cs, d, "VMSnapshot")
+	if err != nil {
+		return fmt.Errorf("Error setting tags on the new VM snapshot %s: %s", d.Id(), err)
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	d.Partial(false)
+	return resourceCosmicVMSnapshotRead(d, meta)
+}
+
+// createVMSnapshotWithMemory starts a job creating a VM snapshot including the
+// memory of the virtual machine. go-cosmic doesn't support the snapshotmemory
+// parameter, so the request is made directly.
+func createVMSnapshotWithMemory(cs *Client, d *schema.ResourceData) (*cosmic.CreateVMSnapshotResponse, error) {
+	params := url.Values{}
+	params.Set("virtualmachineid", d.Get("virtual_machine_id").(string))
+	params.Set("snapshotmemory", "true")
+
+	if name, ok := d.GetOk("name"); ok {
+		params.Set("name", name.(string))
+	}
+
+	if description, ok := d.GetOk("description"); ok {
+		params.Set("description", description.(string))
+	}
+
+	// Don't retry, as the snapshot may be created even if the request fails
+	b, err := cs.rawRequest("createVMSnapshot", params)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &cosmic.CreateVMSnapshotResponse{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func resourceCosmicVMSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Snapshot.NewListVMSnapshotParams()
+	p.SetVmsnapshotid(d.Id())
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return err
+	}
+
+	// Get the VM snapshot details
+	l, err := cs.Snapshot.ListVMSnapshot(p)
+	if err != nil {
+		return err
+	}
+
+	if l.Count == 0 {
+		log.Printf("[DEBUG] VM snapshot %s no longer exists", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	s := l.VMSnapshot[0]
+
+	d.Set("virtual_machine_id", s.Virtualmachineid)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("snapshot_memory", s.Type == "DiskAndMemory")
+	d.Set("state", s.State)
+	d.Set("current", s.Current)
+
+	setValueOrID(d, "project", s.Project, s.Projectid)
+
+	tags, err := getTags(cs, d, "VMSnapshot")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
+	return nil
+}
+
+func resourceCosmicVMSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+	d.Partial(true)
+
+	// Revert the virtual machine to this snapshot if the trigger is changed
+	if d.HasChange("revert_on_change") && d.Get("revert_on_change").(string) != "" {
+		// Create a new parameter struct
+		p := cs.Snapshot.NewRevertToVMSnapshotParams(d.Id())
+
+		// Revert the virtual machine
+		log.Printf("[INFO] Reverting virtual machine %s to VM snapshot %s",
+			d.Get("virtual_machine_id").(string), d.Id())
+		r, err := cs.noWait.Snapshot.RevertToVMSnapshot(p)
+		if err == nil {
+			err = cs.waitForJob(r.JobID, asyncTimeout(d, schema.TimeoutUpdate), nil)
+		}
+		if err != nil {
+			return fmt.Errorf("Error reverting virtual machine %s to VM snapshot %s: %s",
+				d.Get("virtual_machine_id").(string), d.Id(), err)
+		}
+
+		d.SetPartial("revert_on_change")
+	}
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "VMSnapshot")
+		if err != nil {
+			return fmt.Errorf("Error updating tags on VM snapshot %s: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+	return resourceCosmicVMSnapshotRead(d, meta)
+}
+
+func resourceCosmicVMSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Snapshot.NewDeleteVMSnapshotParams(d.Id())
+
+	// Delete the VM snapshot
+	log.Printf("[INFO] Deleting VM snapshot: %s", d.Id())
+	r, err := cs.noWait.Snapshot.DeleteVMSnapshot(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, asyncTimeout(d, schema.TimeoutDelete), nil)
+	}
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VM snapshot %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
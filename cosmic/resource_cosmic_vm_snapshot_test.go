@@ -0,0 +1,204 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCosmicVMSnapshot_basic(t *testing.T) {
+	var snapshot cosmic.VMSnapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicVMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVMSnapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicVMSnapshotExists(
+						"cosmic_vm_snapshot.foo", &snapshot),
+					testAccCheckCosmicVMSnapshotAttributes(&snapshot),
+					resource.TestCheckResourceAttr(
+						"cosmic_vm_snapshot.foo", "state", "Ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicVMSnapshot_volumeSnapshot(t *testing.T) {
+	var snapshot cosmic.VMSnapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicVMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVMSnapshot_volumeSnapshot,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicVMSnapshotExists(
+						"cosmic_vm_snapshot.foo", &snapshot),
+					resource.TestCheckResourceAttr(
+						"cosmic_volume_snapshot.foo", "state", "BackedUp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCosmicVMSnapshotExists(
+	n string, snapshot *cosmic.VMSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VM snapshot ID is set")
+		}
+
+		cs := testAccProvider.Meta().(*Client)
+		p := cs.Snapshot.NewListVMSnapshotParams()
+		p.SetVmsnapshotid(rs.Primary.ID)
+
+		l, err := cs.Snapshot.ListVMSnapshot(p)
+		if err != nil {
+			return err
+		}
+
+		if l.Count != 1 || l.VMSnapshot[0].Id != rs.Primary.ID {
+			return fmt.Errorf("VM snapshot not found")
+		}
+
+		*snapshot = *l.VMSnapshot[0]
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicVMSnapshotAttributes(
+	snapshot *cosmic.VMSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if snapshot.Name != "terraform-vm-snapshot" {
+			return fmt.Errorf("Bad name: %s", snapshot.Name)
+		}
+
+		if snapshot.Description != "terraform-test" {
+			return fmt.Errorf("Bad description: %s", snapshot.Description)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicVMSnapshotDestroy(s *terraform.State) error {
+	cs := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cosmic_vm_snapshot" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VM snapshot ID is set")
+		}
+
+		p := cs.Snapshot.NewListVMSnapshotParams()
+		p.SetVmsnapshotid(rs.Primary.ID)
+
+		l, err := cs.Snapshot.ListVMSnapshot(p)
+		if err != nil {
+			return err
+		}
+
+		if l.Count > 0 {
+			return fmt.Errorf("VM snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccCosmicVMSnapshot_basic = fmt.Sprintf(`
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}
+
+resource "cosmic_vm_snapshot" "foo" {
+  virtual_machine_id = "${cosmic_instance.foo.id}"
+  name               = "terraform-vm-snapshot"
+  description        = "terraform-test"
+}`,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE)
+
+var testAccCosmicVMSnapshot_volumeSnapshot = fmt.Sprintf(`
+resource "cosmic_network" "foo" {
+  name             = "terraform-network"
+  cidr             = "10.0.10.0/24"
+  gateway          = "10.0.10.1"
+  network_offering = "%s"
+  vpc_id           = "%s"
+  zone             = "%s"
+}
+
+resource "cosmic_instance" "foo" {
+  name             = "terraform-test"
+  display_name     = "terraform"
+  service_offering = "%s"
+  network_id       = "${cosmic_network.foo.id}"
+  template         = "%s"
+  zone             = "${cosmic_network.foo.zone}"
+  expunge          = true
+}
+
+resource "cosmic_disk" "foo" {
+  name               = "terraform-disk"
+  attach             = true
+  size               = "10"
+  disk_offering      = "%s"
+  virtual_machine_id = "${cosmic_instance.foo.id}"
+  zone               = "${cosmic_instance.foo.zone}"
+}
+
+resource "cosmic_vm_snapshot" "foo" {
+  virtual_machine_id = "${cosmic_disk.foo.virtual_machine_id}"
+  name               = "terraform-vm-snapshot"
+}
+
+resource "cosmic_volume_snapshot" "foo" {
+  volume_id      = "${cosmic_disk.foo.id}"
+  vm_snapshot_id = "${cosmic_vm_snapshot.foo.id}"
+}`,
+	COSMIC_VPC_NETWORK_OFFERING,
+	COSMIC_VPC_ID,
+	COSMIC_ZONE,
+	COSMIC_SERVICE_OFFERING_1,
+	COSMIC_TEMPLATE,
+	COSMIC_DISK_OFFERING_1)
@@ -0,0 +1,264 @@
+package cosmic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCosmicVolumeSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCosmicVolumeSnapshotCreate,
+		Read:   resourceCosmicVolumeSnapshotRead,
+		Update: resourceCosmicVolumeSnapshotUpdate,
+		Delete: resourceCosmicVolumeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"vm_snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"revert_on_change": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"revertable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceCosmicVolumeSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+	d.Partial(true)
+
+	volumeid := d.Get("volume_id").(string)
+
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
+	var id string
+	if vmsnapshotid, ok := d.GetOk("vm_snapshot_id"); ok {
+		// Create a new parameter struct
+		p := cs.Snapshot.NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid.(string), volumeid)
+
+		if name, ok := d.GetOk("name"); ok {
+			p.SetName(name.(string))
+		}
+
+		// Create the new snapshot from the VM snapshot
+		r, err := cs.noWait.Snapshot.CreateSnapshotFromVMSnapshot(p)
+		if err == nil {
+			err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+		}
+		if err != nil {
+			return fmt.Errorf("Error creating a snapshot of volume %s from VM snapshot %s: %s",
+				volumeid, vmsnapshotid.(string), err)
+		}
+		id = r.Id
+	} else {
+		// Create a new parameter struct
+		p := cs.Snapshot.NewCreateSnapshotParams(volumeid)
+
+		if name, ok := d.GetOk("name"); ok {
+			p.SetName(name.(string))
+		}
+
+		// Create the new snapshot
+		r, err := cs.noWait.Snapshot.CreateSnapshot(p)
+		if err == nil {
+			err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+		}
+		if err != nil {
+			return fmt.Errorf("Error creating a snapshot of volume %s: %s", volumeid, err)
+		}
+		id = r.Id
+	}
+
+	d.SetId(id)
+	d.SetPartial("volume_id")
+	d.SetPartial("vm_snapshot_id")
+	d.SetPartial("name")
+	d.SetPartial("revert_on_change")
+	d.SetPartial("project")
+
+	err := setTags(cs, d, "Snapshot")
+	if err != nil {
+		return fmt.Errorf("Error setting tags on the new snapshot %s: %s", id, err)
+	}
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	// Wait until the snapshot is backed up, or timeout with an error...
+	currentTime := time.Now()
+	for {
+		err := resourceCosmicVolumeSnapshotRead(d, meta)
+		if err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return fmt.Errorf("Snapshot %s was removed while waiting for it to be backed up", id)
+		}
+
+		switch d.Get("state").(string) {
+		case "BackedUp":
+			d.Partial(false)
+			return nil
+		case "Error":
+			return fmt.Errorf("Error backing up snapshot %s", id)
+		}
+
+		if time.Since(currentTime) > time.Duration(timeout)*time.Second {
+			return fmt.Errorf("Timeout while waiting for snapshot %s to be backed up", id)
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func resourceCosmicVolumeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Get the snapshot details
+	s, _, err := cs.Snapshot.GetSnapshotByID(
+		d.Id(),
+		withProject(cs, d),
+	)
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			log.Printf("[DEBUG] Snapshot %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("volume_id", s.Volumeid)
+	d.Set("name", s.Name)
+	d.Set("state", s.State)
+	d.Set("revertable", s.Revertable)
+
+	setValueOrID(d, "project", s.Project, s.Projectid)
+
+	tags, err := getTags(cs, d, "Snapshot")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
+	return nil
+}
+
+func resourceCosmicVolumeSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+	d.Partial(true)
+
+	// Revert the volume to this snapshot if the trigger is changed
+	if d.HasChange("revert_on_change") && d.Get("revert_on_change").(string) != "" {
+		if !d.Get("revertable").(bool) {
+			return fmt.Errorf(
+				"Error reverting volume %s: snapshot %s is not revertable", d.Get("volume_id").(string), d.Id())
+		}
+
+		// Create a new parameter struct
+		p := cs.Snapshot.NewRevertSnapshotParams(d.Id())
+
+		timeout := asyncTimeout(d, schema.TimeoutUpdate)
+
+		// Revert the volume
+		log.Printf("[INFO] Reverting volume %s to snapshot %s", d.Get("volume_id").(string), d.Id())
+		r, err := cs.noWait.Snapshot.RevertSnapshot(p)
+		if err == nil {
+			err = cs.waitForJob(r.JobID, timeout, nil)
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"Error reverting volume %s to snapshot %s: %s", d.Get("volume_id").(string), d.Id(), err)
+		}
+
+		d.SetPartial("revert_on_change")
+	}
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "Snapshot")
+		if err != nil {
+			return fmt.Errorf("Error updating tags on snapshot %s: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+	return resourceCosmicVolumeSnapshotRead(d, meta)
+}
+
+func resourceCosmicVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Create a new parameter struct
+	p := cs.Snapshot.NewDeleteSnapshotParams(d.Id())
+
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
+	// Delete the snapshot
+	log.Printf("[INFO] Deleting snapshot: %s", d.Id())
+	r, err := cs.noWait.Snapshot.DeleteSnapshot(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
+	if err != nil {
+		if isNotFound(err, d.Id()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting snapshot %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
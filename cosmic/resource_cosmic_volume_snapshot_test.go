@@ -0,0 +1,161 @@
+package cosmic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCosmicVolumeSnapshot_basic(t *testing.T) {
+	var snapshot cosmic.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVolumeSnapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicVolumeSnapshotExists(
+						"cosmic_volume_snapshot.foo", &snapshot),
+					testAccCheckCosmicVolumeSnapshotAttributes(&snapshot),
+					resource.TestCheckResourceAttr(
+						"cosmic_volume_snapshot.foo", "state", "BackedUp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCosmicVolumeSnapshot_revert(t *testing.T) {
+	var snapshot cosmic.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCosmicVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCosmicVolumeSnapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicVolumeSnapshotExists(
+						"cosmic_volume_snapshot.foo", &snapshot),
+					testAccCheckCosmicVolumeSnapshotAttributes(&snapshot),
+				),
+			},
+
+			{
+				Config: testAccCosmicVolumeSnapshot_revert,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCosmicVolumeSnapshotExists(
+						"cosmic_volume_snapshot.foo", &snapshot),
+					resource.TestCheckResourceAttr(
+						"cosmic_volume_snapshot.foo", "revert_on_change", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCosmicVolumeSnapshotExists(
+	n string, snapshot *cosmic.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No snapshot ID is set")
+		}
+
+		cs := testAccProvider.Meta().(*Client)
+		snap, _, err := cs.Snapshot.GetSnapshotByID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if snap.Id != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *snap
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicVolumeSnapshotAttributes(
+	snapshot *cosmic.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if snapshot.Name != "terraform-snapshot" {
+			return fmt.Errorf("Bad name: %s", snapshot.Name)
+		}
+
+		if snapshot.Volumename != "terraform-disk" {
+			return fmt.Errorf("Bad volume: %s", snapshot.Volumename)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCosmicVolumeSnapshotDestroy(s *terraform.State) error {
+	cs := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cosmic_volume_snapshot" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No snapshot ID is set")
+		}
+
+		_, _, err := cs.Snapshot.GetSnapshotByID(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+var testAccCosmicVolumeSnapshot_basic = fmt.Sprintf(`
+resource "cosmic_disk" "foo" {
+  name          = "terraform-disk"
+  attach        = false
+  size          = "10"
+  disk_offering = "%s"
+  zone          = "%s"
+}
+
+resource "cosmic_volume_snapshot" "foo" {
+  volume_id = "${cosmic_disk.foo.id}"
+  name      = "terraform-snapshot"
+}`,
+	COSMIC_DISK_OFFERING_1,
+	COSMIC_ZONE)
+
+var testAccCosmicVolumeSnapshot_revert = fmt.Sprintf(`
+resource "cosmic_disk" "foo" {
+  name          = "terraform-disk"
+  attach        = false
+  size          = "10"
+  disk_offering = "%s"
+  zone          = "%s"
+}
+
+resource "cosmic_volume_snapshot" "foo" {
+  volume_id        = "${cosmic_disk.foo.id}"
+  name             = "terraform-snapshot"
+  revert_on_change = "1"
+}`,
+	COSMIC_DISK_OFFERING_1,
+	COSMIC_ZONE)
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -18,6 +16,12 @@ func resourceCosmicVPC() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Update: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -52,7 +56,6 @@ func resourceCosmicVPC() *schema.Resource {
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 				ForceNew: true,
 			},
 
@@ -73,15 +76,19 @@ func resourceCosmicVPC() *schema.Resource {
 
 			"zone": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 
@@ -135,27 +142,37 @@ func resourceCosmicVPCCreate(d *schema.ResourceData, meta interface{}) error {
 		p.SetSyslogserverlist(syslogServerList.(string))
 	}
 
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
 	// Create the new VPC
-	r, err := cs.VPC.CreateVPC(p)
+	r, err := cs.noWait.VPC.CreateVPC(p)
+	if err == nil {
+		err = cs.waitForCreate(d, r.Id, r.JobID, timeout, r)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating VPC %s: %s", name, err)
 	}
 
 	d.SetId(r.Id)
 
+	err = setTags(cs, d, "Vpc")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicVPCRead(d, meta)
 }
 
 func resourceCosmicVPCRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the VPC details
-	v, count, err := cs.VPC.GetVPCByID(
+	v, _, err := cs.VPC.GetVPCByID(
 		d.Id(),
-		cosmic.WithProject(d.Get("project").(string)),
+		withProject(cs, d),
 	)
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] VPC %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
@@ -182,6 +199,12 @@ func resourceCosmicVPCRead(d *schema.ResourceData, meta interface{}) error {
 	setValueOrID(d, "project", v.Project, v.Projectid)
 	setValueOrID(d, "zone", v.Zonename, v.Zoneid)
 
+	tags, err := getTags(cs, d, "Vpc")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	// Create a new parameter struct
 	p := cs.PublicIPAddress.NewListPublicIpAddressesParams()
 	p.SetVpcid(d.Id())
@@ -206,7 +229,7 @@ func resourceCosmicVPCRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmicVPCUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	name := d.Get("name").(string)
 
@@ -260,28 +283,43 @@ func resourceCosmicVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 		p.SetVpcofferingid(o.Id)
 	}
 
+	timeout := asyncTimeout(d, schema.TimeoutUpdate)
+
 	// Update the VPC
-	_, err := cs.VPC.UpdateVPC(p)
+	r, err := cs.noWait.VPC.UpdateVPC(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
 		return fmt.Errorf("Error updating name of VPC %s: %s", name, err)
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "Vpc")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceCosmicVPCRead(d, meta)
 }
 
 func resourceCosmicVPCDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPC.NewDeleteVPCParams(d.Id())
 
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
 	// Delete the VPC
-	_, err := cs.VPC.DeleteVPC(p)
+	r, err := cs.noWait.VPC.DeleteVPC(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
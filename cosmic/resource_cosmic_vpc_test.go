@@ -25,6 +25,8 @@ func TestAccCosmicVPC_basic(t *testing.T) {
 					testAccCheckCosmicVPCAttributes(&vpc),
 					resource.TestCheckResourceAttr(
 						"cosmic_vpc.foo", "vpc_offering", COSMIC_VPC_OFFERING),
+					resource.TestCheckResourceAttr(
+						"cosmic_vpc.foo", "tags.terraform-tag", "true"),
 				),
 			},
 		},
@@ -43,7 +45,7 @@ func testAccCheckCosmicVPCExists(
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		v, _, err := cs.VPC.GetVPCByID(rs.Primary.ID)
 
 		if err != nil {
@@ -85,7 +87,7 @@ func testAccCheckCosmicVPCAttributes(
 }
 
 func testAccCheckCosmicVPCDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_vpc" {
@@ -113,6 +115,10 @@ resource "cosmic_vpc" "foo" {
   vpc_offering   = "%s"
   network_domain = "terraform-domain"
   zone           = "%s"
+
+  tags = {
+    terraform-tag = "true"
+  }
 }`,
 	COSMIC_VPC_OFFERING,
 	COSMIC_ZONE)
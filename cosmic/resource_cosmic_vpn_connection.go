@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -13,10 +11,16 @@ func resourceCosmicVPNConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCosmicVPNConnectionCreate,
 		Read:   resourceCosmicVPNConnectionRead,
+		Update: resourceCosmicVPNConnectionUpdate,
 		Delete: resourceCosmicVPNConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultAsyncTimeout),
+			Delete: schema.DefaultTimeout(defaultAsyncTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"customer_gateway_id": &schema.Schema{
@@ -30,12 +34,16 @@ func resourceCosmicVPNConnection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicVPNConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewCreateVpnConnectionParams(
@@ -43,24 +51,34 @@ func resourceCosmicVPNConnectionCreate(d *schema.ResourceData, meta interface{})
 		d.Get("vpn_gateway_id").(string),
 	)
 
+	timeout := asyncTimeout(d, schema.TimeoutCreate)
+
 	// Create the new VPN Connection
-	v, err := cs.VPN.CreateVpnConnection(p)
+	v, err := cs.noWait.VPN.CreateVpnConnection(p)
+	if err == nil {
+		err = cs.waitForCreate(d, v.Id, v.JobID, timeout, v)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating VPN Connection: %s", err)
 	}
 
 	d.SetId(v.Id)
 
+	err = setTags(cs, d, "VpnConnection")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicVPNConnectionRead(d, meta)
 }
 
 func resourceCosmicVPNConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the VPN Connection details
-	v, count, err := cs.VPN.GetVpnConnectionByID(d.Id())
+	v, _, err := cs.VPN.GetVpnConnectionByID(d.Id())
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf("[DEBUG] VPN Connection does no longer exist")
 			d.SetId("")
 			return nil
@@ -72,22 +90,44 @@ func resourceCosmicVPNConnectionRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("customer_gateway_id", v.S2scustomergatewayid)
 	d.Set("vpn_gateway_id", v.S2svpngatewayid)
 
+	tags, err := getTags(cs, d, "VpnConnection")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
+func resourceCosmicVPNConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "VpnConnection")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicVPNConnectionRead(d, meta)
+}
+
 func resourceCosmicVPNConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewDeleteVpnConnectionParams(d.Id())
 
+	timeout := asyncTimeout(d, schema.TimeoutDelete)
+
 	// Delete the VPN Connection
-	_, err := cs.VPN.DeleteVpnConnection(p)
+	r, err := cs.noWait.VPN.DeleteVpnConnection(p)
+	if err == nil {
+		err = cs.waitForJob(r.JobID, timeout, nil)
+	}
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
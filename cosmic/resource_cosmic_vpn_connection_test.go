@@ -42,7 +42,7 @@ func testAccCheckCosmicVPNConnectionExists(
 			return fmt.Errorf("No VPN Connection ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		v, _, err := cs.VPN.GetVpnConnectionByID(rs.Primary.ID)
 
 		if err != nil {
@@ -60,7 +60,7 @@ func testAccCheckCosmicVPNConnectionExists(
 }
 
 func testAccCheckCosmicVPNConnectionDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_vpn_connection" {
@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -18,6 +16,7 @@ func resourceCosmicVPNCustomerGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -69,12 +68,16 @@ func resourceCosmicVPNCustomerGateway() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicVPNCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewCreateVpnCustomerGatewayParams(
@@ -107,16 +110,21 @@ func resourceCosmicVPNCustomerGatewayCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(v.Id)
 
+	err = setTags(cs, d, "CustomerGateway")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicVPNCustomerGatewayRead(d, meta)
 }
 
 func resourceCosmicVPNCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the VPN Customer Gateway details
-	v, count, err := cs.VPN.GetVpnCustomerGatewayByID(d.Id())
+	v, _, err := cs.VPN.GetVpnCustomerGatewayByID(d.Id())
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] VPN Customer Gateway %s does no longer exist", d.Get("name").(string))
 			d.SetId("")
@@ -136,11 +144,17 @@ func resourceCosmicVPNCustomerGatewayRead(d *schema.ResourceData, meta interface
 	d.Set("esp_lifetime", int(v.Esplifetime))
 	d.Set("ike_lifetime", int(v.Ikelifetime))
 
+	tags, err := getTags(cs, d, "CustomerGateway")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
 func resourceCosmicVPNCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewUpdateVpnCustomerGatewayParams(
@@ -172,11 +186,19 @@ func resourceCosmicVPNCustomerGatewayUpdate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error updating VPN Customer Gateway %s: %s", d.Get("name").(string), err)
 	}
 
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "CustomerGateway")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
 	return resourceCosmicVPNCustomerGatewayRead(d, meta)
 }
 
 func resourceCosmicVPNCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewDeleteVpnCustomerGatewayParams(d.Id())
@@ -184,10 +206,7 @@ func resourceCosmicVPNCustomerGatewayDelete(d *schema.ResourceData, meta interfa
 	// Delete the VPN Customer Gateway
 	_, err := cs.VPN.DeleteVpnCustomerGateway(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
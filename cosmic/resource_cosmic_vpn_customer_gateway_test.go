@@ -49,7 +49,7 @@ func testAccCheckCosmicVPNCustomerGatewayExists(
 			return fmt.Errorf("No VPN CustomerGateway ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		v, _, err := cs.VPN.GetVpnCustomerGatewayByID(rs.Primary.ID)
 
 		if err != nil {
@@ -87,7 +87,7 @@ func testAccCheckCosmicVPNCustomerGatewayAttributes(
 }
 
 func testAccCheckCosmicVPNCustomerGatewayDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_vpn_customer_gateway" {
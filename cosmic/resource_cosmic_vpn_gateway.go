@@ -3,9 +3,7 @@ package cosmic
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -13,10 +11,12 @@ func resourceCosmicVPNGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCosmicVPNGatewayCreate,
 		Read:   resourceCosmicVPNGatewayRead,
+		Update: resourceCosmicVPNGatewayUpdate,
 		Delete: resourceCosmicVPNGatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -29,12 +29,16 @@ func resourceCosmicVPNGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
 func resourceCosmicVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	vpcid := d.Get("vpc_id").(string)
 	p := cs.VPN.NewCreateVpnGatewayParams(vpcid)
@@ -47,16 +51,21 @@ func resourceCosmicVPNGatewayCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(v.Id)
 
+	err = setTags(cs, d, "VpnGateway")
+	if err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
 	return resourceCosmicVPNGatewayRead(d, meta)
 }
 
 func resourceCosmicVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Get the VPN Gateway details
-	v, count, err := cs.VPN.GetVpnGatewayByID(d.Id())
+	v, _, err := cs.VPN.GetVpnGatewayByID(d.Id())
 	if err != nil {
-		if count == 0 {
+		if isNotFound(err, d.Id()) {
 			log.Printf(
 				"[DEBUG] VPN Gateway for VPC ID %s does no longer exist", d.Get("vpc_id").(string))
 			d.SetId("")
@@ -69,11 +78,31 @@ func resourceCosmicVPNGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpc_id", v.Vpcid)
 	d.Set("public_ip", v.Publicip)
 
+	tags, err := getTags(cs, d, "VpnGateway")
+	if err != nil {
+		return fmt.Errorf("Error reading tags: %s", err)
+	}
+	setTagsState(cs, d, tags)
+
 	return nil
 }
 
+func resourceCosmicVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	cs := meta.(*Client)
+
+	// Update tags if they have changed
+	if d.HasChange("tags_all") {
+		err := setTags(cs, d, "VpnGateway")
+		if err != nil {
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
+	}
+
+	return resourceCosmicVPNGatewayRead(d, meta)
+}
+
 func resourceCosmicVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	cs := meta.(*cosmic.CosmicClient)
+	cs := meta.(*Client)
 
 	// Create a new parameter struct
 	p := cs.VPN.NewDeleteVpnGatewayParams(d.Id())
@@ -81,10 +110,7 @@ func resourceCosmicVPNGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	// Delete the VPN Gateway
 	_, err := cs.VPN.DeleteVpnGateway(p)
 	if err != nil {
-		// This is a very poor way to be told the ID does no longer exist :(
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", d.Id())) {
+		if isNotFound(err, d.Id()) {
 			return nil
 		}
 
@@ -40,7 +40,7 @@ func testAccCheckCosmicVPNGatewayExists(
 			return fmt.Errorf("No VPN Gateway ID is set")
 		}
 
-		cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+		cs := testAccProvider.Meta().(*Client)
 		v, _, err := cs.VPN.GetVpnGatewayByID(rs.Primary.ID)
 
 		if err != nil {
@@ -58,7 +58,7 @@ func testAccCheckCosmicVPNGatewayExists(
 }
 
 func testAccCheckCosmicVPNGatewayDestroy(s *terraform.State) error {
-	cs := testAccProvider.Meta().(*cosmic.CosmicClient)
+	cs := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cosmic_vpn_gateway" {
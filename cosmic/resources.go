@@ -1,10 +1,13 @@
 package cosmic
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
-	"time"
+	"strings"
 
 	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +16,15 @@ import (
 // Define a regexp for parsing the port
 var splitPorts = regexp.MustCompile(`^(\d+)(?:-(\d+))?$`)
 
+// Define the layout used by Cosmic for timestamps
+const cosmicTimeLayout = "2006-01-02T15:04:05-0700"
+
+// Errors returned by data sources when a query doesn't return a single result
+var (
+	errNoResults       = errors.New("Your query returned no results. Please change your search criteria and try again.")
+	errMultipleResults = errors.New("Your query returned more than one result. Please try a more specific search criteria.")
+)
+
 type retrieveError struct {
 	name  string
 	value string
@@ -37,12 +49,26 @@ func setValueOrID(d *schema.ResourceData, key string, value string, id string) {
 	}
 }
 
-func retrieveID(cs *cosmic.CosmicClient, name string, value string, opts ...cosmic.OptionFunc) (id string, e *retrieveError) {
+func retrieveID(cs *Client, name string, value string) (id string, e *retrieveError) {
+	// Fall back to the default zone of the provider
+	if name == "zone" && value == "" {
+		value = cs.config.DefaultZone
+		if value == "" {
+			return id, &retrieveError{name: name, value: value,
+				err: errors.New("No zone configured and the provider has no default zone")}
+		}
+	}
+
 	// If the supplied value isn't a ID, try to retrieve the ID ourselves
 	if cosmic.IsID(value) {
 		return value, nil
 	}
 
+	key := lookupKey{kind: name, name: value}
+	if id, ok := cs.config.lookupCache.get(key); ok {
+		return id, nil
+	}
+
 	log.Printf("[DEBUG] Retrieving ID of %s: %s", name, value)
 
 	// Ignore counts, since an error is returned if there is no exact match
@@ -82,68 +108,194 @@ func retrieveID(cs *cosmic.CosmicClient, name string, value string, opts ...cosm
 		return id, &retrieveError{name: name, value: value, err: err}
 	}
 
+	cs.config.lookupCache.set(key, id)
+
 	return id, nil
 }
 
-func retrieveTemplateID(cs *cosmic.CosmicClient, zoneid, value string) (id string, e *retrieveError) {
+func retrieveTemplateID(cs *Client, zoneid, project, value string) (id string, e *retrieveError) {
 	// If the supplied value isn't a ID, try to retrieve the ID ourselves
 	if cosmic.IsID(value) {
 		return value, nil
 	}
 
+	key := lookupKey{kind: "template", name: value, zone: zoneid, project: project}
+	if id, ok := cs.config.lookupCache.get(key); ok {
+		return id, nil
+	}
+
 	log.Printf("[DEBUG] Retrieving ID of template: %s", value)
 
+	projectid, e := retrieveProjectID(cs, project)
+	if e != nil {
+		return id, e
+	}
+
 	// Ignore count, since an error is returned if there is no exact match
-	id, _, err := cs.Template.GetTemplateID(value, "executable", zoneid)
+	id, _, err := cs.Template.GetTemplateID(value, "executable", zoneid, cosmic.WithProject(projectid))
 	if err != nil {
 		return id, &retrieveError{name: "template", value: value, err: err}
 	}
 
+	cs.config.lookupCache.set(key, id)
+
+	return id, nil
+}
+
+func retrieveISOID(cs *Client, zoneid, project, value string) (id string, e *retrieveError) {
+	// If the supplied value isn't a ID, try to retrieve the ID ourselves
+	if cosmic.IsID(value) {
+		return value, nil
+	}
+
+	key := lookupKey{kind: "iso", name: value, zone: zoneid, project: project}
+	if id, ok := cs.config.lookupCache.get(key); ok {
+		return id, nil
+	}
+
+	log.Printf("[DEBUG] Retrieving ID of ISO: %s", value)
+
+	projectid, e := retrieveProjectID(cs, project)
+	if e != nil {
+		return id, e
+	}
+
+	// The "executable" filter only returns bootable ISOs, while non-bootable
+	// ISOs can be attached as well, so fall back to the ISOs of the account
+	// itself. The "all" filter would return both, but requires an admin. Ignore
+	// count, since an error is returned if there is no exact match
+	id, _, err := cs.ISO.GetIsoID(value, "executable", zoneid, cosmic.WithProject(projectid))
+	if isNotFound(err, value) {
+		id, _, err = cs.ISO.GetIsoID(value, "self", zoneid, cosmic.WithProject(projectid))
+	}
+	if err != nil {
+		return id, &retrieveError{name: "iso", value: value, err: err}
+	}
+
+	cs.config.lookupCache.set(key, id)
+
 	return id, nil
 }
 
-// RetryFunc is the function retried n times
-type RetryFunc func() (interface{}, error)
+// retrieveProjectID returns the ID of the given project, or an empty string
+// if no project is given.
+func retrieveProjectID(cs *Client, project string) (string, *retrieveError) {
+	if project == "" {
+		return "", nil
+	}
 
-// Retry is a wrapper around a RetryFunc that will retry a function
-// n times or until it succeeds.
-func Retry(n int, f RetryFunc) (interface{}, error) {
-	var lastErr error
+	return retrieveID(cs, "project", project)
+}
 
-	for i := 0; i < n; i++ {
-		r, err := f()
-		if err == nil || err == cosmic.AsyncTimeoutErr {
-			return r, err
-		}
+// nameMatcher returns a function that reports if a name matches the regex
+// configured in the "name_regex" field. If no regex is configured, all
+// names match.
+func nameMatcher(d *schema.ResourceData) (func(string) bool, error) {
+	nameRegex, ok := d.GetOk("name_regex")
+	if !ok {
+		return func(string) bool { return true }, nil
+	}
 
-		lastErr = err
-		time.Sleep(30 * time.Second)
+	r, err := regexp.Compile(nameRegex.(string))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid name_regex %q: %s", nameRegex.(string), err)
 	}
 
-	return nil, lastErr
+	return r.MatchString, nil
+}
+
+// listID returns an ID for a data source returning a list of results, based
+// on the IDs of all the results
+func listID(ids []string) string {
+	hash := sha1.Sum([]byte(strings.Join(ids, ",")))
+	return hex.EncodeToString(hash[:])
 }
 
 // If there is a project supplied, we retrieve and set the project id
-func setProjectid(p cosmic.ProjectIDSetter, cs *cosmic.CosmicClient, d *schema.ResourceData) error {
-	if project, ok := d.GetOk("project"); ok {
-		projectid, e := retrieveID(cs, "project", project.(string))
-		if e != nil {
-			return e.Error()
-		}
+func setProjectid(p cosmic.ProjectIDSetter, cs *Client, d *schema.ResourceData) error {
+	projectid, e := retrieveProjectID(cs, getProject(cs, d))
+	if e != nil {
+		return e.Error()
+	}
+
+	if projectid != "" {
 		p.SetProjectid(projectid)
 	}
 
 	return nil
 }
 
-func isCosmic(cs *cosmic.CosmicClient) bool {
-	l := cs.Configuration.NewListCapabilitiesParams()
-	c, err := cs.Configuration.ListCapabilities(l)
-	if err != nil {
-		fmt.Errorf("Unable to retrieve capabilities: %s", err)
-		return false
+// withProject returns an option that sets the project of the resource. The
+// project ID is retrieved using the lookup cache, instead of retrieving it
+// for every call like cosmic.WithProject does.
+func withProject(cs *Client, d *schema.ResourceData) cosmic.OptionFunc {
+	project := getProject(cs, d)
+
+	// If the ID cannot be retrieved, cosmic.WithProject returns the error
+	if projectid, e := retrieveProjectID(cs, project); e == nil {
+		project = projectid
+	}
+
+	return cosmic.WithProject(project)
+}
+
+// getProject returns the configured project, or the default project of the
+// provider if the resource has a project field that is not set.
+func getProject(cs *Client, d *schema.ResourceData) string {
+	if project, ok := d.GetOk("project"); ok {
+		return project.(string)
+	}
+
+	// Resources without a project field live in the project of their parent
+	if d.Get("project") == nil {
+		return ""
+	}
+
+	return cs.config.DefaultProject
+}
+
+// suppressDefaultDiff returns a DiffSuppressFunc for the project or zone of a
+// resource. If the project or zone is not configured, the resource uses the
+// default of the provider, so there is only a diff if the resource lives in
+// another project or zone than the default.
+func suppressDefaultDiff(cs *Client, key string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if new != "" || old == "" {
+			return false
+		}
+
+		defaultValue := cs.config.DefaultProject
+		if key == "zone" {
+			defaultValue = cs.config.DefaultZone
+		}
+
+		if defaultValue == "" {
+			return false
+		}
+
+		if old == defaultValue {
+			return true
+		}
+
+		// The state holds the name while the default may be an ID, or the other
+		// way around, so compare the IDs
+		oldID, e := retrieveID(cs, key, old)
+		if e != nil {
+			return false
+		}
+		defaultID, e := retrieveID(cs, key, defaultValue)
+		if e != nil {
+			return false
+		}
+
+		return oldID == defaultID
 	}
-	return c.Capabilities.Cosmic
+}
+
+// isCosmic returns true if the API is a Cosmic API, based on the capabilities
+// retrieved when the provider was configured.
+func isCosmic(cs *Client) bool {
+	return cs.capabilities != nil && cs.capabilities.Cosmic
 }
 
 func createCidrList(cidrs *schema.Set) []string {
@@ -0,0 +1,116 @@
+package cosmic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestGetProject(t *testing.T) {
+	c := &Config{DefaultProject: "default"}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	withProject := map[string]*schema.Schema{
+		"project": {Type: schema.TypeString, Optional: true},
+	}
+	withoutProject := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+	}
+
+	cases := []struct {
+		Schema  map[string]*schema.Schema
+		Raw     map[string]interface{}
+		Project string
+	}{
+		{
+			Schema:  withProject,
+			Raw:     map[string]interface{}{"project": "configured"},
+			Project: "configured",
+		},
+		{
+			Schema:  withProject,
+			Raw:     map[string]interface{}{},
+			Project: "default",
+		},
+		{
+			Schema:  withoutProject,
+			Raw:     map[string]interface{}{},
+			Project: "",
+		},
+	}
+
+	for i, tc := range cases {
+		d := schema.TestResourceDataRaw(t, tc.Schema, tc.Raw)
+		if p := getProject(cs, d); p != tc.Project {
+			t.Fatalf("%d: bad project: %q (expected %q)", i, p, tc.Project)
+		}
+	}
+}
+
+func TestSuppressDefaultDiff(t *testing.T) {
+	r := resourceCosmicInstanceGroup()
+
+	c := &Config{DefaultProject: "default"}
+	cs, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	r.Schema["project"].DiffSuppressFunc = suppressDefaultDiff(cs, "project")
+
+	cases := []struct {
+		Config   map[string]interface{}
+		Project  string
+		ForceNew bool
+	}{
+		// Lives in the default project and no project is configured
+		{
+			Config:  map[string]interface{}{"name": "foo"},
+			Project: "default",
+		},
+		// Lives in another project, like a former default project
+		{
+			Config:   map[string]interface{}{"name": "foo"},
+			Project:  "other",
+			ForceNew: true,
+		},
+		// Lives in the configured project
+		{
+			Config:  map[string]interface{}{"name": "foo", "project": "other"},
+			Project: "other",
+		},
+		// Lives in another project than the configured project
+		{
+			Config:   map[string]interface{}{"name": "foo", "project": "default"},
+			Project:  "other",
+			ForceNew: true,
+		},
+	}
+
+	for i, tc := range cases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		state := &terraform.InstanceState{
+			ID: "foo",
+			Attributes: map[string]string{
+				"name":    "foo",
+				"project": tc.Project,
+			},
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(rc), nil)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if forceNew := diff != nil && diff.RequiresNew(); forceNew != tc.ForceNew {
+			t.Fatalf("%d: bad force new: %t (expected %t)", i, forceNew, tc.ForceNew)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package cosmic
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+)
+
+const (
+	// defaultRetryMaxBackoff is the default maximum time to wait between retries
+	defaultRetryMaxBackoff = 30 * time.Second
+
+	// retryMinBackoff is the time to wait before the first retry
+	retryMinBackoff = 1 * time.Second
+)
+
+// terminalErrorCodes are the Cosmic API error codes for which retrying the
+// same call is not going to give a different result
+var terminalErrorCodes = map[int]bool{
+	401: true, // Unauthorized
+	405: true, // Method not allowed
+	430: true, // Malformed parameter
+	431: true, // Invalid parameter
+	432: true, // Unsupported action
+	531: true, // Account error
+	532: true, // Account resource limit exceeded
+}
+
+// RetryFunc is the function retried by Retry
+type RetryFunc func() (interface{}, error)
+
+// Retry is a wrapper around a RetryFunc that will retry a function until it
+// succeeds, returns an error that cannot be fixed by retrying or the maximum
+// number of retries is reached. This is the maximum configured for the client,
+// or if none is configured, the function is called at most attempts times.
+func Retry(cs *Client, attempts int, f RetryFunc) (interface{}, error) {
+	c := cs.config
+
+	maxRetries := attempts - 1
+	if c.MaxRetries >= 0 {
+		maxRetries = c.MaxRetries
+	}
+
+	return retry(maxRetries, c.RetryMaxBackoff, f)
+}
+
+func retry(maxRetries int, maxBackoff time.Duration, f RetryFunc) (interface{}, error) {
+	for i := 0; ; i++ {
+		r, err := f()
+		if err == nil || i >= maxRetries || !isRetryable(err) {
+			return r, err
+		}
+
+		time.Sleep(retryBackoff(i, maxBackoff))
+	}
+}
+
+// retryBackoff returns the time to wait before the given retry, doubling the
+// wait after each retry up to maxBackoff. Half of the wait is randomized to
+// prevent concurrent retries from hitting the API at the same time.
+func retryBackoff(retry int, maxBackoff time.Duration) time.Duration {
+	backoff := maxBackoff
+	if retry < 32 && retryMinBackoff<<uint(retry) < maxBackoff {
+		backoff = retryMinBackoff << uint(retry)
+	}
+
+	if backoff < 2 {
+		return backoff
+	}
+
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)))
+}
+
+// isRetryable returns false for errors that will not go away by retrying the
+// same call and true for all other errors (e.g. network or internal errors).
+func isRetryable(err error) bool {
+	if err == cosmic.AsyncTimeoutErr {
+		return false
+	}
+
+	if e, ok := toAPIError(err); ok {
+		return !terminalErrorCodes[e.ErrorCode]
+	}
+
+	return true
+}
@@ -0,0 +1,160 @@
+package cosmic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
+)
+
+func TestIsRetryable(t *testing.T) {
+	cases := []struct {
+		Err       error
+		Retryable bool
+	}{
+		{
+			Err:       fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Unable to execute API command"),
+			Retryable: false,
+		},
+		{
+			Err:       fmt.Errorf("Cosmic API error 530 (CSExceptionErrorCode: 9999): Internal error"),
+			Retryable: true,
+		},
+		{
+			Err:       fmt.Errorf(`{"errorcode":401,"errortext":"unable to verify user credentials"}`),
+			Retryable: false,
+		},
+		{
+			Err:       fmt.Errorf(`{"errorcode":536,"errortext":"Resource is in use"}`),
+			Retryable: true,
+		},
+		{
+			Err:       &APIError{ErrorCode: 432, Message: "Unsupported action"},
+			Retryable: false,
+		},
+		{
+			Err:       &APIError{ErrorCode: 530, Message: "Internal error"},
+			Retryable: true,
+		},
+		{
+			Err:       errors.New("dial tcp: connection refused"),
+			Retryable: true,
+		},
+		{
+			Err:       cosmic.AsyncTimeoutErr,
+			Retryable: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if r := isRetryable(tc.Err); r != tc.Retryable {
+			t.Fatalf("%d: bad retryable: %t (expected %t) for %q", i, r, tc.Retryable, tc.Err)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	max := 30 * time.Second
+
+	for i := 0; i < 64; i++ {
+		b := retryBackoff(i, max)
+
+		upper := max
+		if i < 5 {
+			upper = retryMinBackoff << uint(i)
+		}
+
+		if b < upper/2 || b > upper {
+			t.Fatalf("%d: backoff %s not between %s and %s", i, b, upper/2, upper)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	cases := []struct {
+		Errs     []error
+		Retries  int
+		Calls    int
+		Succeeds bool
+	}{
+		// Succeeds after transient errors
+		{
+			Errs:     []error{errors.New("transient"), errors.New("transient")},
+			Retries:  5,
+			Calls:    3,
+			Succeeds: true,
+		},
+
+		// Stops at a terminal error
+		{
+			Errs: []error{
+				errors.New("transient"),
+				fmt.Errorf("Cosmic API error 431 (CSExceptionErrorCode: 4350): Invalid parameter"),
+			},
+			Retries:  5,
+			Calls:    2,
+			Succeeds: false,
+		},
+
+		// Gives up after the maximum number of retries
+		{
+			Errs:     []error{errors.New("1"), errors.New("2"), errors.New("3")},
+			Retries:  1,
+			Calls:    2,
+			Succeeds: false,
+		},
+	}
+
+	for i, tc := range cases {
+		calls := 0
+		_, err := retry(tc.Retries, time.Millisecond, func() (interface{}, error) {
+			calls++
+			if calls <= len(tc.Errs) {
+				return nil, tc.Errs[calls-1]
+			}
+			return nil, nil
+		})
+
+		if calls != tc.Calls {
+			t.Fatalf("%d: bad number of calls: %d (expected %d)", i, calls, tc.Calls)
+		}
+		if (err == nil) != tc.Succeeds {
+			t.Fatalf("%d: bad result: %v", i, err)
+		}
+	}
+}
+
+func TestRetryMaxRetries(t *testing.T) {
+	cases := []struct {
+		Attempts         int
+		ClientMaxRetries int
+		Calls            int
+	}{
+		// No maximum configured, so the attempts of the call are used
+		{Attempts: 4, ClientMaxRetries: -1, Calls: 4},
+		{Attempts: 10, ClientMaxRetries: -1, Calls: 10},
+		// The configured maximum is used, even if it is higher
+		{Attempts: 4, ClientMaxRetries: 10, Calls: 11},
+		{Attempts: 10, ClientMaxRetries: 1, Calls: 2},
+		{Attempts: 10, ClientMaxRetries: 0, Calls: 1},
+	}
+
+	for i, tc := range cases {
+		cs := &Client{config: &Config{MaxRetries: tc.ClientMaxRetries, RetryMaxBackoff: time.Millisecond}}
+
+		calls := 0
+		_, err := Retry(cs, tc.Attempts, func() (interface{}, error) {
+			calls++
+			return nil, errors.New("transient")
+		})
+
+		if err == nil {
+			t.Fatalf("%d: expected an error", i)
+		}
+		if calls != tc.Calls {
+			t.Fatalf("%d: bad number of calls: %d (expected %d)", i, calls, tc.Calls)
+		}
+	}
+}
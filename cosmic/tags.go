@@ -2,35 +2,47 @@ package cosmic
 
 import (
 	"log"
+	"reflect"
 
-	"github.com/MissionCriticalCloud/go-cosmic/cosmic"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// tagsSchema returns the schema to use for tags
+// tagsSchema returns the schema to use for the configured tags of a resource
 func tagsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
 		Optional: true,
+	}
+}
+
+// tagsAllSchema returns the schema to use for all tags of a resource, which
+// are the configured tags merged with the default tags of the provider
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
 		Computed: true,
 	}
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(cs *cosmic.CosmicClient, d *schema.ResourceData, resourcetype string) error {
-	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
+// tags fields to be named "tags" and "tags_all"
+func setTags(cs *Client, d *schema.ResourceData, resourcetype string) error {
+	o, n := d.GetChange("tags_all")
 
+	return updateTags(cs, []string{d.Id()}, resourcetype, o.(map[string]interface{}), n.(map[string]interface{}))
+}
+
+// updateTags replaces the old tags with the new tags on all the given
+// resources, which must all be of the same resource type
+func updateTags(cs *Client, ids []string, resourcetype string, o, n map[string]interface{}) error {
 	remove, create := diffTags(tagsFromSchema(o), tagsFromSchema(n))
 	log.Printf("[DEBUG] tags to remove: %v", remove)
 	log.Printf("[DEBUG] tags to create: %v", create)
 
 	// First remove any obsolete tags
 	if len(remove) > 0 {
-		log.Printf("[DEBUG] Removing tags: %v from %s", remove, d.Id())
-		p := cs.Resourcetags.NewDeleteTagsParams([]string{d.Id()}, resourcetype)
+		log.Printf("[DEBUG] Removing tags: %v from %v", remove, ids)
+		p := cs.Resourcetags.NewDeleteTagsParams(ids, resourcetype)
 		p.SetTags(remove)
 		_, err := cs.Resourcetags.DeleteTags(p)
 		if err != nil {
@@ -40,8 +52,8 @@ func setTags(cs *cosmic.CosmicClient, d *schema.ResourceData, resourcetype strin
 
 	// Then add any new tags
 	if len(create) > 0 {
-		log.Printf("[DEBUG] Creating tags: %v for %s", create, d.Id())
-		p := cs.Resourcetags.NewCreateTagsParams([]string{d.Id()}, resourcetype, create)
+		log.Printf("[DEBUG] Creating tags: %v for %v", create, ids)
+		p := cs.Resourcetags.NewCreateTagsParams(ids, resourcetype, create)
 		_, err := cs.Resourcetags.CreateTags(p)
 		if err != nil {
 			return err
@@ -51,6 +63,93 @@ func setTags(cs *cosmic.CosmicClient, d *schema.ResourceData, resourcetype strin
 	return nil
 }
 
+// getTags is a helper to retrieve the current tags of a resource. The
+// returned map can be used to set the tags fields using setTagsState
+func getTags(cs *Client, d *schema.ResourceData, resourcetype string) (map[string]interface{}, error) {
+	return getResourceTags(cs, d, d.Id(), resourcetype)
+}
+
+// getResourceTags retrieves the current tags of the resource with the given
+// ID. The project of the resource is read from the "project" field, if any
+func getResourceTags(cs *Client, d *schema.ResourceData, id string, resourcetype string) (map[string]interface{}, error) {
+	p := cs.Resourcetags.NewListTagsParams()
+	p.SetResourceid(id)
+	p.SetResourcetype(resourcetype)
+	p.SetListall(true)
+
+	// If there is a project supplied, we retrieve and set the project id
+	if err := setProjectid(p, cs, d); err != nil {
+		return nil, err
+	}
+
+	l, err := cs.Resourcetags.ListTags(p)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]interface{}, l.Count)
+	for _, t := range l.Tags {
+		tags[t.Key] = t.Value
+	}
+
+	return tags, nil
+}
+
+// setTagsState stores the tags read from the API. All tags are stored in
+// "tags_all", while "tags" leaves out the default tags of the provider, unless
+// the same tag was already part of the configured tags
+func setTagsState(cs *Client, d *schema.ResourceData, tags map[string]interface{}) {
+	configured := d.Get("tags").(map[string]interface{})
+
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if dv, ok := cs.config.DefaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	d.Set("tags", result)
+	d.Set("tags_all", tags)
+}
+
+// customizeTagsDiff merges the provider default tags into the configured tags
+// of a resource, so the plan shows the effective tags in "tags_all". As "tags"
+// is not computed, its new value is always the configured value.
+func customizeTagsDiff(d *schema.ResourceDiff, meta interface{}) error {
+	cs, ok := meta.(*Client)
+	if !ok {
+		return nil
+	}
+
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	merged := mergeTags(cs.config.DefaultTags, d.Get("tags").(map[string]interface{}))
+
+	if reflect.DeepEqual(merged, d.Get("tags_all")) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", merged)
+}
+
+// mergeTags returns the default tags combined with the given tags. The given
+// tags take precedence over the default tags
+func mergeTags(defaults map[string]string, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaults)+len(tags))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
 // diffTags takes the old and the new tag sets and returns the difference of
 // both. The remaining tags are those that need to be removed and created
 func diffTags(oldTags, newTags map[string]string) (map[string]string, map[string]string) {
@@ -3,7 +3,12 @@ package cosmic
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestDiffTags(t *testing.T) {
@@ -55,6 +60,179 @@ func TestDiffTags(t *testing.T) {
 	}
 }
 
+func TestMergeTags(t *testing.T) {
+	cases := []struct {
+		Defaults map[string]string
+		Tags     map[string]interface{}
+		Result   map[string]interface{}
+	}{
+		// No default tags
+		{
+			Defaults: nil,
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Result: map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+
+		// Default tags are added
+		{
+			Defaults: map[string]string{
+				"owner": "terraform",
+			},
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Result: map[string]interface{}{
+				"foo":   "bar",
+				"owner": "terraform",
+			},
+		},
+
+		// Resource tags take precedence
+		{
+			Defaults: map[string]string{
+				"owner": "terraform",
+			},
+			Tags: map[string]interface{}{
+				"owner": "someone-else",
+			},
+			Result: map[string]interface{}{
+				"owner": "someone-else",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		r := mergeTags(tc.Defaults, tc.Tags)
+		if !reflect.DeepEqual(r, tc.Result) {
+			t.Fatalf("%d: bad result: %#v", i, r)
+		}
+	}
+}
+
+func TestCustomizeTagsDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+		CustomizeDiff: customizeTagsDiff,
+	}
+
+	cases := []struct {
+		Defaults map[string]string
+		State    map[string]string
+		Config   map[string]interface{}
+		TagsAll  map[string]string
+	}{
+		// Default tags are added to the configured tags
+		{
+			Defaults: map[string]string{"owner": "terraform"},
+			State:    map[string]string{},
+			Config:   map[string]interface{}{"tags": map[string]interface{}{"foo": "bar"}},
+			TagsAll:  map[string]string{"foo": "bar", "owner": "terraform"},
+		},
+
+		// A removed default tag is removed from a resource without tags
+		{
+			Defaults: map[string]string{"owner": "terraform"},
+			State: map[string]string{
+				"tags.%":         "0",
+				"tags_all.%":     "2",
+				"tags_all.owner": "terraform",
+				"tags_all.env":   "prod",
+			},
+			Config:  map[string]interface{}{},
+			TagsAll: map[string]string{"owner": "terraform"},
+		},
+
+		// A removed default tag is kept if it is configured on the resource
+		{
+			Defaults: map[string]string{"owner": "terraform"},
+			State: map[string]string{
+				"tags.%":         "1",
+				"tags.env":       "prod",
+				"tags_all.%":     "2",
+				"tags_all.owner": "terraform",
+				"tags_all.env":   "prod",
+			},
+			Config: map[string]interface{}{"tags": map[string]interface{}{"env": "prod"}},
+		},
+	}
+
+	for i, tc := range cases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		meta := &Client{config: &Config{DefaultTags: tc.Defaults}}
+		state := &terraform.InstanceState{ID: "foo", Attributes: tc.State}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		if tc.TagsAll == nil {
+			if diff != nil && len(diff.Attributes) > 0 {
+				t.Fatalf("%d: expected no diff, got: %#v", i, diff.Attributes)
+			}
+			continue
+		}
+
+		// Apply the diff of the tags_all field to the state
+		tagsAll := map[string]string{}
+		for k, v := range tc.State {
+			if strings.HasPrefix(k, "tags_all.") && k != "tags_all.%" {
+				tagsAll[strings.TrimPrefix(k, "tags_all.")] = v
+			}
+		}
+		for k, a := range diff.Attributes {
+			if !strings.HasPrefix(k, "tags_all.") || k == "tags_all.%" {
+				continue
+			}
+			if a.NewRemoved {
+				delete(tagsAll, strings.TrimPrefix(k, "tags_all."))
+			} else {
+				tagsAll[strings.TrimPrefix(k, "tags_all.")] = a.New
+			}
+		}
+
+		if !reflect.DeepEqual(tagsAll, tc.TagsAll) {
+			t.Fatalf("%d: bad tags_all: %#v", i, tagsAll)
+		}
+	}
+}
+
+func TestSetTagsState(t *testing.T) {
+	r := map[string]*schema.Schema{
+		"tags":     tagsSchema(),
+		"tags_all": tagsAllSchema(),
+	}
+
+	d := schema.TestResourceDataRaw(t, r, map[string]interface{}{
+		"tags": map[string]interface{}{"env": "prod"},
+	})
+
+	cs := &Client{config: &Config{DefaultTags: map[string]string{"env": "prod", "owner": "terraform"}}}
+	setTagsState(cs, d, map[string]interface{}{"env": "prod", "owner": "terraform", "foo": "bar"})
+
+	// Default tags are left out, unless they are configured on the resource
+	expected := map[string]interface{}{"env": "prod", "foo": "bar"}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("bad tags: %#v", tags)
+	}
+
+	expected = map[string]interface{}{"env": "prod", "owner": "terraform", "foo": "bar"}
+	if tags := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("bad tags_all: %#v", tags)
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(tags map[string]string, key string, value string) error {
 	v, ok := tags[key]
@@ -0,0 +1,271 @@
+package cosmic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// limitedTransport is a http.RoundTripper that limits the rate and the number
+// of concurrent requests to the Cosmic API. It is shared by all clients of a
+// provider, so the limits apply to all resources together.
+type limitedTransport struct {
+	transport http.RoundTripper
+
+	// Token bucket holding the requests that can be made right away
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// Semaphore holding the requests that are in flight
+	inFlight chan struct{}
+}
+
+// newLimitedTransport returns a transport that makes at most requestsPerSecond
+// requests per second and has at most maxConcurrent requests in flight. A value
+// of 0 disables the corresponding limit.
+func newLimitedTransport(transport http.RoundTripper, requestsPerSecond float64, maxConcurrent int) *limitedTransport {
+	t := &limitedTransport{
+		transport: transport,
+		last:      time.Now(),
+	}
+	t.setRate(requestsPerSecond)
+	t.tokens = t.burst
+	t.setMaxConcurrent(maxConcurrent)
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	inFlight := t.inFlight
+	t.mu.Unlock()
+
+	release := func() {}
+	if inFlight != nil {
+		select {
+		case inFlight <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		release = func() { <-inFlight }
+	}
+
+	if err := t.wait(req); err != nil {
+		release()
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The request is in flight until the response is read and closed
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// wait blocks until the token bucket allows another request.
+func (t *limitedTransport) wait(req *http.Request) error {
+	t.mu.Lock()
+	if t.rate <= 0 {
+		t.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	t.tokens += now.Sub(t.last).Seconds() * t.rate
+	if t.tokens > t.burst {
+		t.tokens = t.burst
+	}
+	t.last = now
+
+	// Take a token now, and wait until it would have been added if needed
+	t.tokens--
+	delay := time.Duration(-t.tokens / t.rate * float64(time.Second))
+	t.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+// releaseOnClose calls release once when the body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// setRate changes the maximum number of requests per second.
+func (t *limitedTransport) setRate(requestsPerSecond float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rate = requestsPerSecond
+	t.burst = requestsPerSecond
+	if t.burst < 1 {
+		t.burst = 1
+	}
+	if t.tokens > t.burst {
+		t.tokens = t.burst
+	}
+}
+
+// setMaxConcurrent changes the maximum number of requests in flight. Requests
+// that are already in flight count towards the previous maximum.
+func (t *limitedTransport) setMaxConcurrent(maxConcurrent int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.inFlight = nil
+	if maxConcurrent > 0 {
+		t.inFlight = make(chan struct{}, maxConcurrent)
+	}
+}
+
+// redactedParams are the request parameters that are never logged
+var redactedParams = map[string]bool{
+	"apikey":     true,
+	"ipsecpsk":   true,
+	"password":   true,
+	"privatekey": true,
+	"secretkey":  true,
+	"signature":  true,
+	"userdata":   true,
+}
+
+// loggingTransport is a http.RoundTripper that logs every call to the Cosmic
+// API, without logging any secrets.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	params := req.URL.Query()
+	if req.Method == "POST" && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := ioutil.ReadAll(body)
+			params, _ = url.ParseQuery(string(b))
+		}
+	}
+	command := params.Get("command")
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		log.Printf("[DEBUG] Cosmic API call %s %s failed after %s: %s",
+			command, formatParams(params), latency, err)
+		return nil, err
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	log.Printf("[DEBUG] Cosmic API call %s %s returned HTTP %d in %s%s",
+		command, formatParams(params), resp.StatusCode, latency, formatResult(b))
+
+	return resp, nil
+}
+
+// formatParams returns the sorted request parameters, with all secrets
+// redacted and leaving out the parameters that are the same for all calls.
+func formatParams(params url.Values) string {
+	var keys []string
+	for k := range params {
+		switch strings.ToLower(k) {
+		case "command", "response":
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var ps []string
+	for _, k := range keys {
+		v := strings.Join(params[k], ",")
+		if redactedParams[strings.ToLower(k)] {
+			v = "<redacted>"
+		}
+		ps = append(ps, k+"="+v)
+	}
+
+	return "(" + strings.Join(ps, ", ") + ")"
+}
+
+// formatResult returns the async job ID and status, and the error of the
+// given response. The rest of the response is left out, as it may contain
+// secrets like generated passwords.
+func formatResult(b []byte) string {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil || len(raw) != 1 {
+		return ""
+	}
+
+	var r struct {
+		JobID     string `json:"jobid"`
+		JobStatus *int   `json:"jobstatus"`
+		JobResult struct {
+			ErrorText string `json:"errortext"`
+		} `json:"jobresult"`
+		ErrorText string `json:"errortext"`
+	}
+	for _, v := range raw {
+		json.Unmarshal(v, &r)
+	}
+
+	var result string
+	if r.JobID != "" {
+		result += " (job " + r.JobID
+		if r.JobStatus != nil {
+			result += fmt.Sprintf(", status %d", *r.JobStatus)
+		}
+		result += ")"
+	}
+	if r.ErrorText != "" {
+		result += ": " + r.ErrorText
+	}
+	if r.JobResult.ErrorText != "" {
+		result += ": " + r.JobResult.ErrorText
+	}
+
+	return result
+}
@@ -0,0 +1,133 @@
+package cosmic
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testTransport struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.inFlight++
+	if t.inFlight > t.maxInFlight {
+		t.maxInFlight = t.inFlight
+	}
+	t.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	t.mu.Lock()
+	t.inFlight--
+	t.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func testRoundTrips(t *testing.T, rt http.RoundTripper, n int) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, _ := http.NewRequest("GET", "https://cosmic.example.com/client/api", nil)
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Errorf("err: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLimitedTransport_maxConcurrent(t *testing.T) {
+	tt := &testTransport{}
+	testRoundTrips(t, newLimitedTransport(tt, 0, 2), 10)
+
+	if tt.maxInFlight > 2 {
+		t.Fatalf("bad number of concurrent requests: %d", tt.maxInFlight)
+	}
+}
+
+func TestLimitedTransport_setMaxConcurrent(t *testing.T) {
+	tt := &testTransport{}
+	lt := newLimitedTransport(tt, 0, 0)
+	lt.setMaxConcurrent(2)
+	testRoundTrips(t, lt, 10)
+
+	if tt.maxInFlight > 2 {
+		t.Fatalf("bad number of concurrent requests: %d", tt.maxInFlight)
+	}
+}
+
+func TestLimitedTransport_rate(t *testing.T) {
+	tt := &testTransport{}
+	start := time.Now()
+	testRoundTrips(t, newLimitedTransport(tt, 20, 0), 30)
+
+	// The first 20 requests are allowed right away, the other 10
+	// should take at least half a second at 20 requests per second
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("requests were not rate limited: took %s", elapsed)
+	}
+}
+
+func TestFormatParams(t *testing.T) {
+	params := url.Values{
+		"apiKey":    {"key"},
+		"command":   {"createVpnCustomerGateway"},
+		"ipsecpsk":  {"psk"},
+		"name":      {"gateway"},
+		"response":  {"json"},
+		"signature": {"signature"},
+	}
+
+	expected := "(apiKey=<redacted>, ipsecpsk=<redacted>, name=gateway, signature=<redacted>)"
+	if p := formatParams(params); p != expected {
+		t.Fatalf("bad params: %s (expected %s)", p, expected)
+	}
+}
+
+func TestFormatResult(t *testing.T) {
+	cases := []struct {
+		Body, Result string
+	}{
+		{
+			Body:   `{"deployvirtualmachineresponse":{"id":"1","jobid":"42"}}`,
+			Result: " (job 42)",
+		},
+		{
+			Body:   `{"queryasyncjobresultresponse":{"jobid":"42","jobstatus":2,"jobresult":{"errorcode":530,"errortext":"failed"}}}`,
+			Result: " (job 42, status 2): failed",
+		},
+		{
+			Body:   `{"listvirtualmachinesresponse":{"errorcode":431,"errortext":"invalid"}}`,
+			Result: ": invalid",
+		},
+		{
+			Body:   `{"listvirtualmachinesresponse":{"count":1,"virtualmachine":[{"password":"secret"}]}}`,
+			Result: "",
+		},
+	}
+
+	for i, tc := range cases {
+		if r := formatResult([]byte(tc.Body)); r != tc.Result {
+			t.Fatalf("%d: bad result: %q (expected %q)", i, r, tc.Result)
+		}
+	}
+}